@@ -35,8 +35,10 @@ func main() {
 		Host:   "https://api.twitter.com",
 	}
 	opts := twitter.ListTweetLookupOpts{
-		Expansions: []twitter.Expansion{twitter.ExpansionAuthorID},
-		UserFields: []twitter.UserField{twitter.UserFieldVerified},
+		TweetSelection: twitter.TweetSelection{
+			Expansions: []twitter.Expansion{twitter.ExpansionAuthorID},
+			UserFields: []twitter.UserField{twitter.UserFieldVerified},
+		},
 	}
 
 	fmt.Println("Callout to list tweet lookup callout")
@@ -37,8 +37,10 @@ func main() {
 		Host:   "https://api.twitter.com/",
 	}
 	opts := twitter.TweetLookupOpts{
-		Expansions:  []twitter.Expansion{twitter.ExpansionEntitiesMentionsUserName, twitter.ExpansionAuthorID},
-		TweetFields: []twitter.TweetField{twitter.TweetFieldCreatedAt, twitter.TweetFieldConversationID, twitter.TweetFieldAttachments},
+		TweetSelection: twitter.TweetSelection{
+			Expansions:  []twitter.Expansion{twitter.ExpansionEntitiesMentionsUserName, twitter.ExpansionAuthorID},
+			TweetFields: []twitter.TweetField{twitter.TweetFieldCreatedAt, twitter.TweetFieldConversationID, twitter.TweetFieldAttachments},
+		},
 	}
 
 	fmt.Println("Twitter HTTP Error Example")
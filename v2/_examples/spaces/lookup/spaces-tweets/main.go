@@ -35,7 +35,9 @@ func main() {
 		Host:   "https://api.twitter.com",
 	}
 	opts := twitter.SpaceTweetsLookupOpts{
-		TweetFields: []twitter.TweetField{twitter.TweetFieldAuthorID},
+		TweetSelection: twitter.TweetSelection{
+			TweetFields: []twitter.TweetField{twitter.TweetFieldAuthorID},
+		},
 	}
 
 	fmt.Println("Callout to spaces tweets callout")
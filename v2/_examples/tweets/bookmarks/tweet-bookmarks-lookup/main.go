@@ -35,9 +35,11 @@ func main() {
 		Host:   "https://api.twitter.com",
 	}
 	opts := twitter.TweetBookmarksLookupOpts{
-		Expansions:  []twitter.Expansion{twitter.ExpansionAuthorID},
-		TweetFields: []twitter.TweetField{twitter.TweetFieldCreatedAt, twitter.TweetFieldConversationID, twitter.TweetFieldAttachments, twitter.TweetFieldAuthorID, twitter.TweetFieldPublicMetrics},
-		UserFields:  []twitter.UserField{twitter.UserFieldUserName},
+		TweetSelection: twitter.TweetSelection{
+			Expansions:  []twitter.Expansion{twitter.ExpansionAuthorID},
+			TweetFields: []twitter.TweetField{twitter.TweetFieldCreatedAt, twitter.TweetFieldConversationID, twitter.TweetFieldAttachments, twitter.TweetFieldAuthorID, twitter.TweetFieldPublicMetrics},
+			UserFields:  []twitter.UserField{twitter.UserFieldUserName},
+		},
 	}
 
 	fmt.Println("Callout to tweet bookmarks lookup callout")
@@ -35,8 +35,10 @@ func main() {
 		Host:   "https://api.twitter.com",
 	}
 	opts := twitter.UserLikesLookupOpts{
-		Expansions:  []twitter.Expansion{twitter.ExpansionPinnedTweetID},
-		TweetFields: []twitter.TweetField{twitter.TweetFieldContextAnnotations},
+		TweetSelection: twitter.TweetSelection{
+			Expansions:  []twitter.Expansion{twitter.ExpansionPinnedTweetID},
+			TweetFields: []twitter.TweetField{twitter.TweetFieldContextAnnotations},
+		},
 	}
 
 	fmt.Println("Callout to user likes lookup callout")
@@ -36,8 +36,10 @@ func main() {
 		Host:   "https://api.twitter.com",
 	}
 	opts := twitter.TweetLookupOpts{
-		Expansions:  []twitter.Expansion{twitter.ExpansionEntitiesMentionsUserName, twitter.ExpansionAuthorID},
-		TweetFields: []twitter.TweetField{twitter.TweetFieldCreatedAt, twitter.TweetFieldConversationID, twitter.TweetFieldAttachments, twitter.TweetFieldEntities},
+		TweetSelection: twitter.TweetSelection{
+			Expansions:  []twitter.Expansion{twitter.ExpansionEntitiesMentionsUserName, twitter.ExpansionAuthorID},
+			TweetFields: []twitter.TweetField{twitter.TweetFieldCreatedAt, twitter.TweetFieldConversationID, twitter.TweetFieldAttachments, twitter.TweetFieldEntities},
+		},
 	}
 
 	fmt.Println("Callout to tweet lookup callout")
@@ -35,10 +35,12 @@ func main() {
 		Host:   "https://api.twitter.com",
 	}
 	opts := twitter.UserTweetTimelineOpts{
-		TweetFields: []twitter.TweetField{twitter.TweetFieldCreatedAt, twitter.TweetFieldAuthorID, twitter.TweetFieldConversationID, twitter.TweetFieldPublicMetrics, twitter.TweetFieldContextAnnotations},
-		UserFields:  []twitter.UserField{twitter.UserFieldUserName},
-		Expansions:  []twitter.Expansion{twitter.ExpansionAuthorID},
-		MaxResults:  5,
+		TweetSelection: twitter.TweetSelection{
+			Expansions:  []twitter.Expansion{twitter.ExpansionAuthorID},
+			TweetFields: []twitter.TweetField{twitter.TweetFieldCreatedAt, twitter.TweetFieldAuthorID, twitter.TweetFieldConversationID, twitter.TweetFieldPublicMetrics, twitter.TweetFieldContextAnnotations},
+			UserFields:  []twitter.UserField{twitter.UserFieldUserName},
+		},
+		MaxResults: 5,
 	}
 
 	fmt.Println("Callout to tweet user tweet timeline callout")
@@ -0,0 +1,58 @@
+package twitter
+
+import "time"
+
+// WebhookObj is the webhook that is registered with the account activity environment
+type WebhookObj struct {
+	ID               string `json:"id"`
+	URL              string `json:"url"`
+	Valid            bool   `json:"valid"`
+	CreatedTimestamp string `json:"created_timestamp"`
+}
+
+// CreatedTimestampTime returns the created timestamp as a time
+func (w WebhookObj) CreatedTimestampTime() (time.Time, error) {
+	return parseV1Time(w.CreatedTimestamp)
+}
+
+// CreateWebhookResponse is the response from registering the webhook
+type CreateWebhookResponse struct {
+	Webhook   *WebhookObj
+	RateLimit *RateLimit
+}
+
+// WebhookLookupResponse is the response from the webhook lookup
+type WebhookLookupResponse struct {
+	Webhooks  []*WebhookObj
+	RateLimit *RateLimit
+}
+
+// WebhookManageResponse is the response from deleting a webhook, triggering the challenge response check
+// and managing the subscriptions, which have no content
+type WebhookManageResponse struct {
+	RateLimit *RateLimit
+}
+
+// WebhookSubscriptionResponse is the response from checking the subscription of the user
+type WebhookSubscriptionResponse struct {
+	Subscribed bool
+	RateLimit  *RateLimit
+}
+
+// WebhookSubscriptionObj is an user that is subscribed to the account activity environment
+type WebhookSubscriptionObj struct {
+	UserID string `json:"user_id"`
+}
+
+// WebhookSubscriptionsObj are the subscriptions of the account activity environment
+type WebhookSubscriptionsObj struct {
+	Environment   string                    `json:"environment"`
+	ApplicationID string                    `json:"application_id"`
+	Subscriptions []*WebhookSubscriptionObj `json:"subscriptions"`
+}
+
+// WebhookSubscriptionsLookupResponse is the response from the subscriptions lookup
+type WebhookSubscriptionsLookupResponse struct {
+	Raw       *WebhookSubscriptionsObj
+	RateLimit *RateLimit
+}
@@ -6,3 +6,21 @@ import "net/http"
 type Authorizer interface {
 	Add(req *http.Request)
 }
+
+// AuthContext is the context of the authorization, which limits the fields that can be requested
+type AuthContext string
+
+const (
+	// AuthContextApp is app only authorization, like a bearer token
+	AuthContextApp AuthContext = "app"
+	// AuthContextUser is authorization on behalf of a user, like OAuth 1.0a or OAuth 2.0 user context
+	AuthContextUser AuthContext = "user"
+)
+
+// AuthContextAuthorizer is an authorizer that knows its authorization context.  If the client's authorizer
+// implements it and the client's auth context is not set, the requested fields that are not available to the
+// context are rejected before the request is sent.
+type AuthContextAuthorizer interface {
+	Authorizer
+	AuthContext() AuthContext
+}
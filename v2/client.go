@@ -4,10 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -41,6 +46,11 @@ const (
 	userRetweetLookupMaxResults                     = 100
 	userTweetReverseChronologicalTimelineMinResults = 1
 	userTweetReverseChronologicalTimelineMaxResults = 100
+	dmEventsMaxResults                              = 100
+	tweetRetweetsLookupMaxResults                   = 100
+	userRepostsOfMeMaxResults                       = 100
+	userSearchMaxResults                            = 1000
+	trendsMaxTrends                                 = 50
 )
 
 // Client is used to make twitter v2 API callouts.
@@ -50,10 +60,23 @@ const (
 // Client is the HTTP client to use for all requests
 //
 // Host is the base URL to use like, https://api.twitter.com
+//
+// UploadHost is the base URL for the media upload, the default is https://upload.twitter.com
+//
+// Compression will request gzip responses, for the streams and the REST APIs, and decompress them as they are read
+//
+// UsageCounter is optional and will count the tweets returned by the search, timeline and stream callouts
+//
+// AuthContext is optional and is the context of the authorizer, the tweet fields that are not available to the
+// context are rejected before the request is sent
 type Client struct {
-	Authorizer Authorizer
-	Client     *http.Client
-	Host       string
+	Authorizer   Authorizer
+	Client       *http.Client
+	Host         string
+	UploadHost   string
+	Compression  bool
+	UsageCounter *TweetUsageCounter
+	AuthContext  AuthContext
 }
 
 // CreateTweet will let a user post polls, quote tweets, tweet with reply setting, tweet with geo, attach
@@ -76,7 +99,7 @@ func (c *Client) CreateTweet(ctx context.Context, tweet CreateTweetRequest) (*Cr
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create tweet response: %w", err)
 	}
@@ -127,7 +150,7 @@ func (c *Client) DeleteTweet(ctx context.Context, id string) (*DeleteTweetRespon
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("delete tweet response: %w", err)
 	}
@@ -164,6 +187,82 @@ func (c *Client) DeleteTweet(ctx context.Context, id string) (*DeleteTweetRespon
 	return raw, nil
 }
 
+// CreateTweetThread will post the segments of the thread in order, each as a reply to the previous tweet.  The posted
+// tweets are added to the thread, so if a segment fails a TweetThreadError is returned and the thread can be posted
+// again to resume or rolled back to delete the posted tweets.
+func (c *Client) CreateTweetThread(ctx context.Context, thread *TweetThread) (*TweetThreadResponse, error) {
+	if thread == nil {
+		return nil, fmt.Errorf("create tweet thread: a thread is required %w", ErrParameter)
+	}
+	reqs, err := thread.requests()
+	if err != nil {
+		return nil, fmt.Errorf("create tweet thread: %w", err)
+	}
+
+	var rl *RateLimit
+	for i := len(thread.Posted); i < len(reqs); i++ {
+		replyID := thread.Opts.InReplyToTweetID
+		if i > 0 {
+			replyID = thread.Posted[i-1].ID
+		}
+		req := reqs[i]
+		if len(replyID) > 0 {
+			req.Reply = &CreateTweetReply{
+				InReplyToTweetID: replyID,
+			}
+		}
+		resp, err := c.CreateTweet(ctx, req)
+		if err != nil {
+			return nil, &TweetThreadError{
+				Index:  i,
+				Posted: append([]*CreateTweetData{}, thread.Posted...),
+				Err:    err,
+			}
+		}
+		if resp.Tweet == nil || len(resp.Tweet.ID) == 0 {
+			return nil, &TweetThreadError{
+				Index:  i,
+				Posted: append([]*CreateTweetData{}, thread.Posted...),
+				Err:    errors.New("the created tweet was not returned"),
+			}
+		}
+		thread.Posted = append(thread.Posted, resp.Tweet)
+		rl = resp.RateLimit
+	}
+
+	return &TweetThreadResponse{
+		Tweets:    append([]*CreateTweetData{}, thread.Posted...),
+		RateLimit: rl,
+	}, nil
+}
+
+// RollbackTweetThread will delete the posted tweets of the thread, the last tweet first.  The deleted tweets are
+// removed from the thread, so if a delete fails the rollback can be tried again.
+func (c *Client) RollbackTweetThread(ctx context.Context, thread *TweetThread) (*TweetThreadRollbackResponse, error) {
+	if thread == nil {
+		return nil, fmt.Errorf("rollback tweet thread: a thread is required %w", ErrParameter)
+	}
+
+	rollback := &TweetThreadRollbackResponse{
+		DeletedIDs: []string{},
+	}
+	for len(thread.Posted) > 0 {
+		tweet := thread.Posted[len(thread.Posted)-1]
+		if tweet == nil || len(tweet.ID) == 0 {
+			thread.Posted = thread.Posted[:len(thread.Posted)-1]
+			continue
+		}
+		resp, err := c.DeleteTweet(ctx, tweet.ID)
+		if err != nil {
+			return nil, fmt.Errorf("rollback tweet thread %s: %w", tweet.ID, err)
+		}
+		thread.Posted = thread.Posted[:len(thread.Posted)-1]
+		rollback.DeletedIDs = append(rollback.DeletedIDs, tweet.ID)
+		rollback.RateLimit = resp.RateLimit
+	}
+	return rollback, nil
+}
+
 // TweetLookup returns information about a tweet or group of tweets specified by a group of tweet ids.
 func (c *Client) TweetLookup(ctx context.Context, ids []string, opts TweetLookupOpts) (*TweetLookupResponse, error) {
 	ep := tweetLookupEndpoint.url(c.Host)
@@ -176,6 +275,9 @@ func (c *Client) TweetLookup(ctx context.Context, ids []string, opts TweetLookup
 		ep += fmt.Sprintf("/%s", ids[0])
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("tweet lookup: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
@@ -190,7 +292,7 @@ func (c *Client) TweetLookup(ctx context.Context, ids []string, opts TweetLookup
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet lookup response: %w", err)
 	}
@@ -271,7 +373,7 @@ func (c *Client) UserLookup(ctx context.Context, ids []string, opts UserLookupOp
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user lookup response: %w", err)
 	}
@@ -346,7 +448,7 @@ func (c *Client) UserRetweetLookup(ctx context.Context, tweetID string, opts Use
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user retweet lookup response: %w", err)
 	}
@@ -415,7 +517,7 @@ func (c *Client) UserNameLookup(ctx context.Context, usernames []string, opts Us
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("username lookup response: %w", err)
 	}
@@ -470,6 +572,74 @@ func (c *Client) UserNameLookup(ctx context.Context, usernames []string, opts Us
 	}, nil
 }
 
+// UserSearch will return the users that match the keyword query
+func (c *Client) UserSearch(ctx context.Context, query string, opts UserSearchOpts) (*UserSearchResponse, error) {
+	switch {
+	case len(query) == 0:
+		return nil, fmt.Errorf("user search: a query is required: %w", ErrParameter)
+	case opts.MaxResults > userSearchMaxResults:
+		return nil, fmt.Errorf("user search: a max results [%d] is required [current: %d]: %w", userSearchMaxResults, opts.MaxResults, ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userSearchEndpoint.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("user search request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+	opts.addQuery(req)
+	q := req.URL.Query()
+	q.Add("query", query)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("user search response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != http.StatusOK {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	respBody := struct {
+		*UserRaw
+		Meta *UserSearchMeta `json:"meta"`
+	}{
+		UserRaw: &UserRaw{},
+	}
+
+	if err := decoder.Decode(&respBody); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      "user search",
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+
+	return &UserSearchResponse{
+		Raw:       respBody.UserRaw,
+		Meta:      respBody.Meta,
+		RateLimit: rl,
+	}, nil
+}
+
 // AuthUserLookup will return the authorized user lookup
 func (c *Client) AuthUserLookup(ctx context.Context, opts UserLookupOpts) (*UserLookupResponse, error) {
 	ep := userAuthLookupEndpoint.url(c.Host)
@@ -482,7 +652,7 @@ func (c *Client) AuthUserLookup(ctx context.Context, opts UserLookupOpts) (*User
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("auth user lookup response: %w", err)
 	}
@@ -536,6 +706,12 @@ func (c *Client) TweetRecentSearch(ctx context.Context, query string, opts Tweet
 		return nil, fmt.Errorf("tweet recent search: the query over the length (%d): %w", tweetRecentSearchQueryLength, ErrParameter)
 	default:
 	}
+	if err := validateIDTimes(opts.SinceID, opts.SinceIDTime, opts.UntilID, opts.UntilIDTime); err != nil {
+		return nil, fmt.Errorf("tweet recent search: %w", err)
+	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("tweet recent search: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetRecentSearchEndpoint.url(c.Host), nil)
 	if err != nil {
@@ -548,7 +724,7 @@ func (c *Client) TweetRecentSearch(ctx context.Context, query string, opts Tweet
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet recent search response: %w", err)
 	}
@@ -598,6 +774,7 @@ func (c *Client) TweetRecentSearch(ctx context.Context, query string, opts Tweet
 		}
 	}
 
+	c.UsageCounter.Add(TweetUsageSourceSearch, len(recentSearch.Raw.Tweets))
 	return recentSearch, nil
 }
 
@@ -612,6 +789,9 @@ func (c *Client) TweetSearch(ctx context.Context, query string, opts TweetSearch
 		return nil, fmt.Errorf("tweet search: the query over the length (%d): %w", tweetSearchQueryLength, ErrParameter)
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("tweet search: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetSearchEndpoint.url(c.Host), nil)
 	if err != nil {
@@ -624,7 +804,7 @@ func (c *Client) TweetSearch(ctx context.Context, query string, opts TweetSearch
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search response: %w", err)
 	}
@@ -662,6 +842,10 @@ func (c *Client) TweetSearch(ctx context.Context, query string, opts TweetSearch
 		}
 	}
 
+	if respBody.TweetRaw != nil {
+		c.UsageCounter.Add(TweetUsageSourceSearch, len(respBody.TweetRaw.Tweets))
+	}
+
 	return &TweetSearchResponse{
 		Raw:       respBody.TweetRaw,
 		Meta:      respBody.Meta,
@@ -669,6 +853,83 @@ func (c *Client) TweetSearch(ctx context.Context, query string, opts TweetSearch
 	}, nil
 }
 
+// TweetConversation will build the reply tree of the conversation.  The conversation is searched and the parents
+// that are not part of the search results are looked up, the tweets that could not be found are gaps of the tree.
+func (c *Client) TweetConversation(ctx context.Context, conversationID string, opts TweetConversationOpts) (*TweetConversationResponse, error) {
+	switch {
+	case len(conversationID) == 0:
+		return nil, fmt.Errorf("tweet conversation: a conversation id is required: %w", ErrParameter)
+	case opts.MaxPages < 0:
+		return nil, fmt.Errorf("tweet conversation: max pages [%d] must not be negative: %w", opts.MaxPages, ErrParameter)
+	default:
+	}
+	if opts.MaxResults <= 0 {
+		opts.MaxResults = tweetConversationMaxResults
+	}
+
+	builder := newTweetConversationBuilder(conversationID, opts.Order)
+	query := "conversation_id:" + conversationID
+
+	var rl *RateLimit
+	nextToken := ""
+	for page := 0; opts.MaxPages == 0 || page < opts.MaxPages; page++ {
+		var raw *TweetRaw
+		switch {
+		case opts.FullArchive:
+			resp, err := c.TweetSearch(ctx, query, TweetSearchOpts{
+				TweetSelection: opts.selection(),
+				MaxResults:     opts.MaxResults,
+				NextToken:      nextToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("tweet conversation search: %w", err)
+			}
+			raw, nextToken, rl = resp.Raw, resp.Meta.NextToken, resp.RateLimit
+		default:
+			resp, err := c.TweetRecentSearch(ctx, query, TweetRecentSearchOpts{
+				TweetSelection: opts.selection(),
+				MaxResults:     opts.MaxResults,
+				NextToken:      nextToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("tweet conversation recent search: %w", err)
+			}
+			raw, nextToken, rl = resp.Raw, resp.Meta.NextToken, resp.RateLimit
+		}
+		builder.add(raw)
+		if len(nextToken) == 0 {
+			break
+		}
+	}
+
+	looked := map[string]bool{}
+	for !opts.SkipLookup {
+		ids := builder.missing(looked)
+		if len(ids) == 0 {
+			break
+		}
+		for len(ids) > 0 {
+			batch := ids
+			if len(batch) > tweetMaxIDs {
+				batch = ids[:tweetMaxIDs]
+			}
+			ids = ids[len(batch):]
+
+			resp, err := c.TweetLookup(ctx, batch, opts.lookupOpts())
+			if err != nil {
+				return nil, fmt.Errorf("tweet conversation lookup: %w", err)
+			}
+			builder.add(resp.Raw)
+			rl = resp.RateLimit
+		}
+	}
+
+	return &TweetConversationResponse{
+		Root:      builder.build(),
+		RateLimit: rl,
+	}, nil
+}
+
 // TweetSearchStreamAddRule will create on or more rules for search sampling.  Set dry run to true to validate the rules before commit
 func (c *Client) TweetSearchStreamAddRule(ctx context.Context, rules []TweetSearchStreamRule, dryRun bool) (*TweetSearchStreamAddRuleResponse, error) {
 	if len(rules) == 0 {
@@ -699,7 +960,7 @@ func (c *Client) TweetSearchStreamAddRule(ctx context.Context, rules []TweetSear
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream add rule http response %w", err)
 	}
@@ -773,7 +1034,7 @@ func (c *Client) TweetSearchStreamDeleteRuleByID(ctx context.Context, ruleIDs []
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream delete rule http response %w", err)
 	}
@@ -843,7 +1104,7 @@ func (c *Client) TweetSearchStreamDeleteRuleByValue(ctx context.Context, ruleVal
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream delete rule http response %w", err)
 	}
@@ -898,7 +1159,7 @@ func (c *Client) TweetSearchStreamRules(ctx context.Context, ruleIDs []TweetSear
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream rules http response %w", err)
 	}
@@ -943,6 +1204,9 @@ func (c *Client) TweetSearchStream(ctx context.Context, opts TweetSearchStreamOp
 		return nil, fmt.Errorf("tweet search stream: a max back off minutes [%d] is [current: %d]: %w", sampleStreamMaxBackOffMin, opts.BackfillMinutes, ErrParameter)
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("tweet search stream: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetSearchStreamEndpoint.url(c.Host), nil)
 	if err != nil {
@@ -952,7 +1216,7 @@ func (c *Client) TweetSearchStream(ctx context.Context, opts TweetSearchStreamOp
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream response: %w", err)
 	}
@@ -975,7 +1239,7 @@ func (c *Client) TweetSearchStream(ctx context.Context, opts TweetSearchStreamOp
 		return nil, e
 	}
 
-	stream := StartTweetStream(resp.Body)
+	stream := startTweetStream(resp.Body, opts.Deduplicator, c.UsageCounter)
 	stream.RateLimit = rl
 	return stream, nil
 }
@@ -1001,7 +1265,7 @@ func (c *Client) TweetRecentCounts(ctx context.Context, query string, opts Tweet
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet recent counts response: %w", err)
 	}
@@ -1065,7 +1329,7 @@ func (c *Client) TweetAllCounts(ctx context.Context, query string, opts TweetAll
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet all counts response: %w", err)
 	}
@@ -1122,7 +1386,7 @@ func (c *Client) UserFollowingLookup(ctx context.Context, id string, opts UserFo
 	q := req.URL.Query()
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user following lookup response: %w", err)
 	}
@@ -1200,7 +1464,7 @@ func (c *Client) UserFollows(ctx context.Context, userID, targetUserID string) (
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user follows response: %w", err)
 	}
@@ -1255,7 +1519,7 @@ func (c *Client) DeleteUserFollows(ctx context.Context, userID, targetUserID str
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete follows response: %w", err)
 	}
@@ -1308,7 +1572,7 @@ func (c *Client) UserFollowersLookup(ctx context.Context, id string, opts UserFo
 	q := req.URL.Query()
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user followers lookup response: %w", err)
 	}
@@ -1371,6 +1635,12 @@ func (c *Client) UserTweetTimeline(ctx context.Context, userID string, opts User
 		return nil, fmt.Errorf("user tweet timeline: max results [%d] have a max[%d] %w", opts.MaxResults, userTweetTimelineMaxResults, ErrParameter)
 	default:
 	}
+	if err := validateIDTimes(opts.SinceID, opts.SinceIDTime, opts.UntilID, opts.UntilIDTime); err != nil {
+		return nil, fmt.Errorf("user tweet timeline: %w", err)
+	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("user tweet timeline: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userTweetTimelineEndpoint.urlID(c.Host, userID), nil)
 	if err != nil {
@@ -1380,7 +1650,7 @@ func (c *Client) UserTweetTimeline(ctx context.Context, userID string, opts User
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user tweet timeline response: %w", err)
 	}
@@ -1428,6 +1698,7 @@ func (c *Client) UserTweetTimeline(ctx context.Context, userID string, opts User
 		}
 	}
 	timeline.RateLimit = rl
+	c.UsageCounter.Add(TweetUsageSourceTimeline, len(timeline.Raw.Tweets))
 	return timeline, nil
 }
 
@@ -1443,6 +1714,12 @@ func (c *Client) UserMentionTimeline(ctx context.Context, userID string, opts Us
 		return nil, fmt.Errorf("user mention timeline: max results [%d] have a max[%d] %w", opts.MaxResults, userMentionTimelineMaxResults, ErrParameter)
 	default:
 	}
+	if err := validateIDTimes(opts.SinceID, opts.SinceIDTime, opts.UntilID, opts.UntilIDTime); err != nil {
+		return nil, fmt.Errorf("user mention timeline: %w", err)
+	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("user mention timeline: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userMentionTimelineEndpoint.urlID(c.Host, userID), nil)
 	if err != nil {
@@ -1452,7 +1729,7 @@ func (c *Client) UserMentionTimeline(ctx context.Context, userID string, opts Us
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user mention timeline response: %w", err)
 	}
@@ -1500,6 +1777,7 @@ func (c *Client) UserMentionTimeline(ctx context.Context, userID string, opts Us
 		}
 	}
 	timeline.RateLimit = rl
+	c.UsageCounter.Add(TweetUsageSourceTimeline, len(timeline.Raw.Tweets))
 	return timeline, nil
 }
 
@@ -1516,6 +1794,9 @@ func (c *Client) UserTweetReverseChronologicalTimeline(ctx context.Context, user
 		return nil, fmt.Errorf("user tweet reverse chronological timeline: max results [%d] have a max[%d] %w", opts.MaxResults, userTweetReverseChronologicalTimelineMaxResults, ErrParameter)
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("user tweet reverse chronological timeline: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userTweetReverseChronologicalTimelineEndpoint.urlID(c.Host, userID), nil)
 	if err != nil {
@@ -1525,7 +1806,7 @@ func (c *Client) UserTweetReverseChronologicalTimeline(ctx context.Context, user
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user tweet reverse chronological timeline response: %w", err)
 	}
@@ -1563,6 +1844,8 @@ func (c *Client) UserTweetReverseChronologicalTimeline(ctx context.Context, user
 		}
 	}
 
+	c.UsageCounter.Add(TweetUsageSourceTimeline, len(timeline.TweetRaw.Tweets))
+
 	return &UserTweetReverseChronologicalTimelineResponse{
 		Raw:       &timeline.TweetRaw,
 		Meta:      &timeline.Meta,
@@ -1593,7 +1876,7 @@ func (c Client) TweetHideReplies(ctx context.Context, id string, hide bool) (*Tw
 	req.Header.Add("Content-Type", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet hide replies response: %w", err)
 	}
@@ -1657,7 +1940,7 @@ func (c *Client) UserRetweet(ctx context.Context, userID, tweetID string) (*User
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user retweet response: %w", err)
 	}
@@ -1712,7 +1995,7 @@ func (c *Client) DeleteUserRetweet(ctx context.Context, userID, tweetID string)
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete retweet response: %w", err)
 	}
@@ -1769,7 +2052,7 @@ func (c *Client) UserBlocksLookup(ctx context.Context, userID string, opts UserB
 	q := req.URL.Query()
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user blocked lookup response: %w", err)
 	}
@@ -1847,7 +2130,7 @@ func (c *Client) UserBlocks(ctx context.Context, userID, targetUserID string) (*
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user blocks response: %w", err)
 	}
@@ -1902,7 +2185,7 @@ func (c *Client) DeleteUserBlocks(ctx context.Context, userID, targetUserID stri
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete blocks response: %w", err)
 	}
@@ -1959,7 +2242,7 @@ func (c *Client) UserMutesLookup(ctx context.Context, userID string, opts UserMu
 	q := req.URL.Query()
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user muted lookup response: %w", err)
 	}
@@ -2037,7 +2320,7 @@ func (c *Client) UserMutes(ctx context.Context, userID, targetUserID string) (*U
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user mutes response: %w", err)
 	}
@@ -2092,7 +2375,7 @@ func (c *Client) DeleteUserMutes(ctx context.Context, userID, targetUserID strin
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete mutes response: %w", err)
 	}
@@ -2152,7 +2435,7 @@ func (c *Client) TweetLikesLookup(ctx context.Context, tweetID string, opts Twee
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user tweet likes lookup response: %w", err)
 	}
@@ -2209,6 +2492,9 @@ func (c *Client) UserLikesLookup(ctx context.Context, userID string, opts UserLi
 		return nil, fmt.Errorf("tweet user likes lookup: a max results [%d] is required [current: %d]: %w", likesMaxResults, opts.MaxResults, ErrParameter)
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("tweet user likes lookup: %w", err)
+	}
 
 	ep := userLikedTweetEndpoint.urlID(c.Host, userID)
 
@@ -2220,7 +2506,7 @@ func (c *Client) UserLikesLookup(ctx context.Context, userID string, opts UserLi
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet user likes lookup response: %w", err)
 	}
@@ -2291,7 +2577,7 @@ func (c *Client) UserLikes(ctx context.Context, userID, tweetID string) (*UserLi
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user likes response: %w", err)
 	}
@@ -2346,7 +2632,7 @@ func (c *Client) DeleteUserLikes(ctx context.Context, userID, tweetID string) (*
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete likes response: %w", err)
 	}
@@ -2391,6 +2677,9 @@ func (c *Client) TweetSampleStream(ctx context.Context, opts TweetSampleStreamOp
 		return nil, fmt.Errorf("tweet sample stream: a max back off minutes [%d] is [current: %d]: %w", sampleStreamMaxBackOffMin, opts.BackfillMinutes, ErrParameter)
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("tweet sample stream: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetSampleStreamEndpoint.url(c.Host), nil)
 	if err != nil {
@@ -2400,7 +2689,7 @@ func (c *Client) TweetSampleStream(ctx context.Context, opts TweetSampleStreamOp
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet sample stream response: %w", err)
 	}
@@ -2423,7 +2712,7 @@ func (c *Client) TweetSampleStream(ctx context.Context, opts TweetSampleStreamOp
 		return nil, e
 	}
 
-	stream := StartTweetStream(resp.Body)
+	stream := startTweetStream(resp.Body, opts.Deduplicator, c.UsageCounter)
 	stream.RateLimit = rl
 	return stream, nil
 }
@@ -2446,7 +2735,7 @@ func (c *Client) ListLookup(ctx context.Context, listID string, opts ListLookupO
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("list lookup response: %w", err)
 	}
@@ -2510,7 +2799,7 @@ func (c *Client) UserListLookup(ctx context.Context, userID string, opts UserLis
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user list lookup response: %w", err)
 	}
@@ -2565,6 +2854,9 @@ func (c *Client) ListTweetLookup(ctx context.Context, listID string, opts ListTw
 		return nil, fmt.Errorf("list tweet lookup: max results [%d] is greater than max [%d]: %w", opts.MaxResults, listTweetMaxResults, ErrParameter)
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("list tweet lookup: %w", err)
+	}
 
 	ep := listTweetLookupEndpoint.urlID(c.Host, listID)
 
@@ -2576,7 +2868,7 @@ func (c *Client) ListTweetLookup(ctx context.Context, listID string, opts ListTw
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("list tweet lookup response: %w", err)
 	}
@@ -2643,7 +2935,7 @@ func (c *Client) CreateList(ctx context.Context, list ListMetaData) (*ListCreate
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create list response: %w", err)
 	}
@@ -2703,7 +2995,7 @@ func (c *Client) UpdateList(ctx context.Context, listID string, update ListMetaD
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("update list response: %w", err)
 	}
@@ -2757,7 +3049,7 @@ func (c *Client) DeleteList(ctx context.Context, listID string) (*ListDeleteResp
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("delete list response: %w", err)
 	}
@@ -2825,7 +3117,7 @@ func (c *Client) AddListMember(ctx context.Context, listID, userID string) (*Lis
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create list member response: %w", err)
 	}
@@ -2881,7 +3173,7 @@ func (c *Client) RemoveListMember(ctx context.Context, listID, userID string) (*
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("remove list member response: %w", err)
 	}
@@ -2940,7 +3232,7 @@ func (c *Client) ListUserMembers(ctx context.Context, listID string, opts ListUs
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("list user members response: %w", err)
 	}
@@ -3006,7 +3298,7 @@ func (c *Client) UserListMemberships(ctx context.Context, userID string, opts Us
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user list membership response: %w", err)
 	}
@@ -3081,7 +3373,7 @@ func (c *Client) UserPinList(ctx context.Context, userID, listID string) (*UserP
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user pin list response: %w", err)
 	}
@@ -3137,7 +3429,7 @@ func (c *Client) UserUnpinList(ctx context.Context, userID, listID string) (*Use
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user unpin list response: %w", err)
 	}
@@ -3193,7 +3485,7 @@ func (c *Client) UserPinnedLists(ctx context.Context, userID string, opts UserPi
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user pinned list response: %w", err)
 	}
@@ -3268,7 +3560,7 @@ func (c *Client) UserFollowList(ctx context.Context, userID, listID string) (*Us
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user follow list response: %w", err)
 	}
@@ -3324,7 +3616,7 @@ func (c *Client) UserUnfollowList(ctx context.Context, userID, listID string) (*
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user unfollow list response: %w", err)
 	}
@@ -3383,7 +3675,7 @@ func (c *Client) UserFollowedLists(ctx context.Context, userID string, opts User
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user followed list response: %w", err)
 	}
@@ -3449,7 +3741,7 @@ func (c *Client) ListUserFollowers(ctx context.Context, listID string, opts List
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("list user followers response: %w", err)
 	}
@@ -3520,7 +3812,7 @@ func (c *Client) SpacesLookup(ctx context.Context, ids []string, opts SpacesLook
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space lookup response: %w", err)
 	}
@@ -3596,7 +3888,7 @@ func (c *Client) SpacesByCreatorLookup(ctx context.Context, userIDs []string, op
 	q.Add("user_ids", strings.Join(userIDs, ","))
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space by creator lookup response: %w", err)
 	}
@@ -3656,7 +3948,7 @@ func (c *Client) SpaceBuyersLookup(ctx context.Context, spaceID string, opts Spa
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space buyers lookup response: %w", err)
 	}
@@ -3703,6 +3995,9 @@ func (c *Client) SpaceTweetsLookup(ctx context.Context, spaceID string, opts Spa
 		return nil, fmt.Errorf("space tweets lookup: an id is required: %w", ErrParameter)
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("space tweets lookup: %w", err)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, spaceTweetsLookupEndpoint.urlID(c.Host, spaceID), nil)
 	if err != nil {
@@ -3712,7 +4007,7 @@ func (c *Client) SpaceTweetsLookup(ctx context.Context, spaceID string, opts Spa
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space tweets lookup response: %w", err)
 	}
@@ -3775,7 +4070,7 @@ func (c *Client) SpacesSearch(ctx context.Context, query string, opts SpacesSear
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space search response: %w", err)
 	}
@@ -3849,8 +4144,9 @@ func (c *Client) CreateComplianceBatchJob(ctx context.Context, jobType Complianc
 	}
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
+	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create compliance batch job response: %w", err)
 	}
@@ -3906,8 +4202,9 @@ func (c *Client) ComplianceBatchJob(ctx context.Context, id string) (*Compliance
 		return nil, fmt.Errorf("compliance batch job request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("compliance batch job response: %w", err)
 	}
@@ -3963,13 +4260,14 @@ func (c *Client) ComplianceBatchJobLookup(ctx context.Context, jobType Complianc
 		return nil, fmt.Errorf("compliance batch job lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
 
 	opts.addQuery(req)
 	q := req.URL.Query()
 	q.Add("type", string(jobType))
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("compliance batch job lookup response: %w", err)
 	}
@@ -4026,6 +4324,9 @@ func (c *Client) QuoteTweetsLookup(ctx context.Context, tweetID string, opts Quo
 		return nil, fmt.Errorf("quote tweets lookup: a max results [%d] is required [current: %d]: %w", quoteTweetMaxResults, opts.MaxResults, ErrParameter)
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("quote tweets lookup: %w", err)
+	}
 
 	ep := quoteTweetLookupEndpoint.urlID(c.Host, tweetID)
 
@@ -4037,7 +4338,7 @@ func (c *Client) QuoteTweetsLookup(ctx context.Context, tweetID string, opts Quo
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("quote tweets lookup response: %w", err)
 	}
@@ -4082,6 +4383,111 @@ func (c *Client) QuoteTweetsLookup(ctx context.Context, tweetID string, opts Quo
 	}, nil
 }
 
+// TweetRetweetsLookup returns the retweets of a tweet as tweets, with the tweet fields and expansions
+func (c *Client) TweetRetweetsLookup(ctx context.Context, tweetID string, opts TweetRetweetsLookupOpts) (*TweetRetweetsLookupResponse, error) {
+	switch {
+	case len(tweetID) == 0:
+		return nil, fmt.Errorf("tweet retweets lookup: an id is required: %w", ErrParameter)
+	case opts.MaxResults > tweetRetweetsLookupMaxResults:
+		return nil, fmt.Errorf("tweet retweets lookup: a max results [%d] is required [current: %d]: %w", tweetRetweetsLookupMaxResults, opts.MaxResults, ErrParameter)
+	default:
+	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("tweet retweets lookup: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetRetweetsLookupEndpoint.urlID(c.Host, tweetID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("tweet retweets lookup request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+	opts.addQuery(req)
+
+	raw, meta, rl, err := c.tweetRetweets(req, "tweet retweets lookup")
+	if err != nil {
+		return nil, err
+	}
+	return &TweetRetweetsLookupResponse{
+		Raw:       raw,
+		Meta:      meta,
+		RateLimit: rl,
+	}, nil
+}
+
+// UserRepostsOfMe returns the reposts of the authorized user's tweets, this requires the user context
+func (c *Client) UserRepostsOfMe(ctx context.Context, opts UserRepostsOfMeOpts) (*UserRepostsOfMeResponse, error) {
+	switch {
+	case opts.MaxResults > userRepostsOfMeMaxResults:
+		return nil, fmt.Errorf("user reposts of me: a max results [%d] is required [current: %d]: %w", userRepostsOfMeMaxResults, opts.MaxResults, ErrParameter)
+	default:
+	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("user reposts of me: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, userRepostsOfMeEndpoint.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("user reposts of me request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+	opts.addQuery(req)
+
+	raw, meta, rl, err := c.tweetRetweets(req, "user reposts of me")
+	if err != nil {
+		return nil, err
+	}
+	return &UserRepostsOfMeResponse{
+		Raw:       raw,
+		Meta:      meta,
+		RateLimit: rl,
+	}, nil
+}
+
+func (c *Client) tweetRetweets(req *http.Request, name string) (*TweetRaw, *TweetRetweetsMeta, *RateLimit, error) {
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%s response: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != http.StatusOK {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, nil, nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, nil, nil, e
+	}
+
+	respBody := struct {
+		*TweetRaw
+		Meta *TweetRetweetsMeta `json:"meta"`
+	}{
+		TweetRaw: &TweetRaw{},
+	}
+
+	if err := decoder.Decode(&respBody); err != nil {
+		return nil, nil, nil, &ResponseDecodeError{
+			Name:      name,
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+	return respBody.TweetRaw, respBody.Meta, rl, nil
+}
+
 // TweetBookmarksLookup allows you to get an authenticated user's 800 most recent bookmarked Tweets
 func (c *Client) TweetBookmarksLookup(ctx context.Context, userID string, opts TweetBookmarksLookupOpts) (*TweetBookmarksLookupResponse, error) {
 	switch {
@@ -4092,6 +4498,9 @@ func (c *Client) TweetBookmarksLookup(ctx context.Context, userID string, opts T
 		return nil, fmt.Errorf("tweet bookmarks lookup: a max results [%d] is required [current: %d]: %w", tweetBookmarksMaxResults, opts.MaxResults, ErrParameter)
 	default:
 	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("tweet bookmarks lookup: %w", err)
+	}
 
 	ep := tweetBookmarksEndpoint.urlID(c.Host, userID)
 
@@ -4103,7 +4512,7 @@ func (c *Client) TweetBookmarksLookup(ctx context.Context, userID string, opts T
 	c.Authorizer.Add(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet bookmarks lookup response: %w", err)
 	}
@@ -4178,7 +4587,7 @@ func (c *Client) AddTweetBookmark(ctx context.Context, userID, tweetID string) (
 	req.Header.Add("Content-Type", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet bookmarks add response: %w", err)
 	}
@@ -4237,7 +4646,7 @@ func (c *Client) RemoveTweetBookmark(ctx context.Context, userID, tweetID string
 	req.Header.Add("Accept", "application/json")
 	c.Authorizer.Add(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet bookmarks remove response: %w", err)
 	}
@@ -4276,3 +4685,910 @@ func (c *Client) RemoveTweetBookmark(ctx context.Context, userID, tweetID string
 
 	return respBody, nil
 }
+
+// TweetComplianceStream will stream tweet compliance events, like deletes, withheld and scrub geo, for a partition
+func (c *Client) TweetComplianceStream(ctx context.Context, opts ComplianceStreamOpts) (*ComplianceStream, error) {
+	return c.complianceStream(ctx, "tweet compliance stream", tweetComplianceStreamEndpoint, tweetComplianceStreamMaxPartition, opts)
+}
+
+// UserComplianceStream will stream user compliance events, like deletes, protects and suspends, for a partition
+func (c *Client) UserComplianceStream(ctx context.Context, opts ComplianceStreamOpts) (*ComplianceStream, error) {
+	return c.complianceStream(ctx, "user compliance stream", userComplianceStreamEndpoint, userComplianceStreamMaxPartition, opts)
+}
+
+// LikeComplianceStream will stream like compliance events for a partition
+func (c *Client) LikeComplianceStream(ctx context.Context, opts ComplianceStreamOpts) (*ComplianceStream, error) {
+	return c.complianceStream(ctx, "like compliance stream", likeComplianceStreamEndpoint, likeComplianceStreamMaxPartition, opts)
+}
+
+func (c *Client) complianceStream(ctx context.Context, name string, ep endpoint, maxPartition int, opts ComplianceStreamOpts) (*ComplianceStream, error) {
+	switch {
+	case opts.Partition < 1 || opts.Partition > maxPartition:
+		return nil, fmt.Errorf("%s: a partition between 1 and %d is required [current: %d]: %w", name, maxPartition, opts.Partition, ErrParameter)
+	case opts.BackfillMinutes > sampleStreamMaxBackOffMin:
+		return nil, fmt.Errorf("%s: a max back off minutes [%d] is [current: %d]: %w", name, sampleStreamMaxBackOffMin, opts.BackfillMinutes, ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", name, err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s response: %w", name, err)
+	}
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		e := &ErrorResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	stream := StartComplianceStream(resp.Body)
+	stream.RateLimit = rl
+	return stream, nil
+}
+
+// TweetFirehoseStream will stream a partition of all tweets real-time
+func (c *Client) TweetFirehoseStream(ctx context.Context, opts TweetPartitionStreamOpts) (*TweetStream, error) {
+	return c.tweetPartitionStream(ctx, "tweet firehose stream", tweetFirehoseStreamEndpoint, tweetFirehoseStreamMaxPartition, opts)
+}
+
+// TweetSample10Stream will stream a partition of 10% of all tweets real-time
+func (c *Client) TweetSample10Stream(ctx context.Context, opts TweetPartitionStreamOpts) (*TweetStream, error) {
+	return c.tweetPartitionStream(ctx, "tweet sample10 stream", tweetSample10StreamEndpoint, tweetSample10StreamMaxPartition, opts)
+}
+
+// TweetPartitionedStream will connect to the partitions of a stream concurrently and merge them into one stream.  Each partition
+// will reconnect, using the reconnect backfill minutes, when the connection is lost.
+func (c *Client) TweetPartitionedStream(ctx context.Context, streamType TweetPartitionStreamType, opts TweetPartitionedStreamOpts) (*TweetPartitionedStream, error) {
+	maxPartition := streamType.maxPartition()
+	switch {
+	case maxPartition == 0:
+		return nil, fmt.Errorf("tweet partitioned stream: unknown stream type %s: %w", streamType, ErrParameter)
+	case opts.ReconnectBackfillMinutes > sampleStreamMaxBackOffMin:
+		return nil, fmt.Errorf("tweet partitioned stream: a max reconnect back off minutes [%d] is [current: %d]: %w", sampleStreamMaxBackOffMin, opts.ReconnectBackfillMinutes, ErrParameter)
+	default:
+	}
+	if len(opts.Partitions) == 0 {
+		for partition := 1; partition <= maxPartition; partition++ {
+			opts.Partitions = append(opts.Partitions, partition)
+		}
+	}
+	seen := map[int]bool{}
+	for _, partition := range opts.Partitions {
+		switch {
+		case partition < 1 || partition > maxPartition:
+			return nil, fmt.Errorf("tweet partitioned stream: a partition between 1 and %d is required [current: %d]: %w", maxPartition, partition, ErrParameter)
+		case seen[partition]:
+			return nil, fmt.Errorf("tweet partitioned stream: partition %d is duplicated: %w", partition, ErrParameter)
+		default:
+		}
+		seen[partition] = true
+	}
+
+	connect := c.TweetFirehoseStream
+	if streamType == TweetPartitionStreamSample10 {
+		connect = c.TweetSample10Stream
+	}
+	return startTweetPartitionedStream(ctx, connect, opts), nil
+}
+
+func (c *Client) tweetPartitionStream(ctx context.Context, name string, ep endpoint, maxPartition int, opts TweetPartitionStreamOpts) (*TweetStream, error) {
+	switch {
+	case opts.Partition < 1 || opts.Partition > maxPartition:
+		return nil, fmt.Errorf("%s: a partition between 1 and %d is required [current: %d]: %w", name, maxPartition, opts.Partition, ErrParameter)
+	case opts.BackfillMinutes > sampleStreamMaxBackOffMin:
+		return nil, fmt.Errorf("%s: a max back off minutes [%d] is [current: %d]: %w", name, sampleStreamMaxBackOffMin, opts.BackfillMinutes, ErrParameter)
+	default:
+	}
+	if err := c.validateTweetSelection(opts.TweetSelection); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", name, err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s response: %w", name, err)
+	}
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		e := &ErrorResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	stream := startTweetStream(resp.Body, opts.Deduplicator, c.UsageCounter)
+	stream.RateLimit = rl
+	return stream, nil
+}
+
+// DMEventsLookup returns the recent direct message events of the authenticated user
+func (c *Client) DMEventsLookup(ctx context.Context, opts DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+	return c.dmEventsLookup(ctx, "dm events lookup", dmEventsEndpoint.url(c.Host), opts)
+}
+
+// DMConversationEventsLookup returns the direct message events of a conversation
+func (c *Client) DMConversationEventsLookup(ctx context.Context, conversationID string, opts DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+	if len(conversationID) == 0 {
+		return nil, fmt.Errorf("dm conversation events lookup: a conversation id is required: %w", ErrParameter)
+	}
+	return c.dmEventsLookup(ctx, "dm conversation events lookup", dmConversationEventsEndpoint.urlID(c.Host, conversationID), opts)
+}
+
+// DMParticipantEventsLookup returns the direct message events of the one to one conversation with a participant
+func (c *Client) DMParticipantEventsLookup(ctx context.Context, participantID string, opts DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+	if len(participantID) == 0 {
+		return nil, fmt.Errorf("dm participant events lookup: a participant id is required: %w", ErrParameter)
+	}
+	return c.dmEventsLookup(ctx, "dm participant events lookup", dmParticipantEventsEndpoint.urlID(c.Host, participantID), opts)
+}
+
+func (c *Client) dmEventsLookup(ctx context.Context, name, ep string, opts DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+	switch {
+	case opts.MaxResults > dmEventsMaxResults:
+		return nil, fmt.Errorf("%s: a max results [%d] is required [current: %d]: %w", name, dmEventsMaxResults, opts.MaxResults, ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", name, err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s response: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != http.StatusOK {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	respBody := struct {
+		*DMEventsRaw
+		Meta *DMEventsMeta `json:"meta"`
+	}{}
+
+	if err := decoder.Decode(&respBody); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      name,
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+	return &DMEventsLookupResponse{
+		Raw:       respBody.DMEventsRaw,
+		Meta:      respBody.Meta,
+		RateLimit: rl,
+	}, nil
+}
+
+// CreateDMParticipantMessage sends a message to the one to one conversation with a participant, creating the conversation if needed
+func (c *Client) CreateDMParticipantMessage(ctx context.Context, participantID string, message CreateDMMessage) (*CreateDMMessageResponse, error) {
+	if len(participantID) == 0 {
+		return nil, fmt.Errorf("create dm participant message: a participant id is required: %w", ErrParameter)
+	}
+	return c.createDMMessage(ctx, "create dm participant message", dmParticipantMessagesEndpoint.urlID(c.Host, participantID), message)
+}
+
+// CreateDMConversationMessage sends a message to an existing conversation
+func (c *Client) CreateDMConversationMessage(ctx context.Context, conversationID string, message CreateDMMessage) (*CreateDMMessageResponse, error) {
+	if len(conversationID) == 0 {
+		return nil, fmt.Errorf("create dm conversation message: a conversation id is required: %w", ErrParameter)
+	}
+	return c.createDMMessage(ctx, "create dm conversation message", dmConversationMessagesEndpoint.urlID(c.Host, conversationID), message)
+}
+
+func (c *Client) createDMMessage(ctx context.Context, name, ep string, message CreateDMMessage) (*CreateDMMessageResponse, error) {
+	if err := message.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("%s marshal error %w", name, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", name, err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s response: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != http.StatusCreated {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	raw := &CreateDMMessageResponse{}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      name,
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+	raw.RateLimit = rl
+	return raw, nil
+}
+
+// CreateDMGroupConversation creates a group conversation with the participants and sends the first message
+func (c *Client) CreateDMGroupConversation(ctx context.Context, conversation CreateDMGroupConversationRequest) (*CreateDMGroupConversationResponse, error) {
+	if err := conversation.validate(); err != nil {
+		return nil, fmt.Errorf("create dm group conversation: %w", err)
+	}
+
+	request := struct {
+		ConversationType string          `json:"conversation_type"`
+		ParticipantIDs   []string        `json:"participant_ids"`
+		Message          CreateDMMessage `json:"message"`
+	}{
+		ConversationType: dmConversationTypeGroup,
+		ParticipantIDs:   conversation.ParticipantIDs,
+		Message:          conversation.Message,
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("create dm group conversation marshal error %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dmConversationsEndpoint.url(c.Host), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create dm group conversation request: %w", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("create dm group conversation response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != http.StatusCreated {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	raw := &CreateDMGroupConversationResponse{}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      "create dm group conversation",
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+	raw.RateLimit = rl
+	return raw, nil
+}
+
+func (c *Client) uploadHost() string {
+	if len(c.UploadHost) > 0 {
+		return c.UploadHost
+	}
+	return mediaUploadDefaultHost
+}
+
+// UploadMedia will upload the media in chunks (INIT, APPEND, FINALIZE) and wait for the media to be processed, using the
+// suggested check after seconds between each STATUS.  If alt text is present, it will be added once the media is processed.
+func (c *Client) UploadMedia(ctx context.Context, media io.Reader, opts MediaUploadOpts) (*MediaUploadResponse, error) {
+	if media == nil {
+		return nil, fmt.Errorf("media upload: media is required: %w", ErrParameter)
+	}
+	if err := opts.validate(media); err != nil {
+		return nil, fmt.Errorf("media upload: %w", err)
+	}
+
+	form := url.Values{}
+	form.Add("command", "INIT")
+	form.Add("total_bytes", strconv.Itoa(opts.TotalBytes))
+	form.Add("media_type", opts.MediaType)
+	if len(opts.MediaCategory) > 0 {
+		form.Add("media_category", string(opts.MediaCategory))
+	}
+	if len(opts.AdditionalOwners) > 0 {
+		form.Add("additional_owners", strings.Join(opts.AdditionalOwners, ","))
+	}
+	obj := &MediaUploadObj{}
+	rl, err := c.mediaUploadCommand(ctx, "media upload init", http.MethodPost, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded", obj, nil)
+	if err != nil {
+		return nil, err
+	}
+	mediaID := obj.ID
+
+	chunk := make([]byte, opts.ChunkSize)
+	sent := 0
+	for segment := 0; ; segment++ {
+		n, readErr := io.ReadFull(media, chunk)
+		if n > 0 {
+			if rl, err = c.mediaUploadAppend(ctx, mediaID, segment, chunk[:n]); err != nil {
+				return nil, err
+			}
+			sent += n
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("media upload append read: %w", readErr)
+		}
+	}
+	if sent != opts.TotalBytes {
+		return nil, fmt.Errorf("media upload: sent %d bytes but total bytes is %d: %w", sent, opts.TotalBytes, ErrParameter)
+	}
+
+	form = url.Values{}
+	form.Add("command", "FINALIZE")
+	form.Add("media_id", mediaID)
+	obj = &MediaUploadObj{}
+	if rl, err = c.mediaUploadCommand(ctx, "media upload finalize", http.MethodPost, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded", obj, nil); err != nil {
+		return nil, err
+	}
+
+	for obj.ProcessingInfo != nil && obj.ProcessingInfo.State != MediaProcessingStateSucceeded {
+		if obj.ProcessingInfo.State == MediaProcessingStateFailed {
+			return nil, &MediaProcessingError{
+				MediaID: mediaID,
+				Err:     obj.ProcessingInfo.Error,
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("media upload status: %w", ctx.Err())
+		case <-time.After(obj.ProcessingInfo.checkAfter()):
+		}
+		status, err := c.MediaUploadStatus(ctx, mediaID)
+		if err != nil {
+			return nil, err
+		}
+		obj = status.Media
+		rl = status.RateLimit
+	}
+
+	if len(opts.AltText) > 0 {
+		metadata, err := c.CreateMediaMetadata(ctx, CreateMediaMetadataRequest{
+			MediaID: mediaID,
+			AltText: opts.AltText,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rl = metadata.RateLimit
+	}
+
+	return &MediaUploadResponse{
+		Media:     obj,
+		RateLimit: rl,
+	}, nil
+}
+
+func (c *Client) mediaUploadAppend(ctx context.Context, mediaID string, segment int, chunk []byte) (*RateLimit, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	if err := writer.WriteField("command", "APPEND"); err != nil {
+		return nil, fmt.Errorf("media upload append: %w", err)
+	}
+	if err := writer.WriteField("media_id", mediaID); err != nil {
+		return nil, fmt.Errorf("media upload append: %w", err)
+	}
+	if err := writer.WriteField("segment_index", strconv.Itoa(segment)); err != nil {
+		return nil, fmt.Errorf("media upload append: %w", err)
+	}
+	part, err := writer.CreateFormFile("media", "blob")
+	if err != nil {
+		return nil, fmt.Errorf("media upload append: %w", err)
+	}
+	if _, err := part.Write(chunk); err != nil {
+		return nil, fmt.Errorf("media upload append: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("media upload append: %w", err)
+	}
+	return c.mediaUploadCommand(ctx, "media upload append", http.MethodPost, body, writer.FormDataContentType(), nil, nil)
+}
+
+// MediaUploadStatus returns the processing status of the uploaded media
+func (c *Client) MediaUploadStatus(ctx context.Context, mediaID string) (*MediaStatusResponse, error) {
+	if len(mediaID) == 0 {
+		return nil, fmt.Errorf("media upload status: a media id is required: %w", ErrParameter)
+	}
+	obj := &MediaUploadObj{}
+	rl, err := c.mediaUploadCommand(ctx, "media upload status", http.MethodGet, nil, "", obj, url.Values{"command": {"STATUS"}, "media_id": {mediaID}})
+	if err != nil {
+		return nil, err
+	}
+	return &MediaStatusResponse{
+		Media:     obj,
+		RateLimit: rl,
+	}, nil
+}
+
+// mediaUploadCommand sends one of the upload commands, the response is decoded into obj if present
+func (c *Client) mediaUploadCommand(ctx context.Context, name, method string, body io.Reader, contentType string, obj interface{}, query url.Values) (*RateLimit, error) {
+	return c.v1Command(ctx, name, method, mediaUploadEndpoint.url(c.uploadHost()), body, contentType, obj, query)
+}
+
+// v1Command sends a v1.1 API request, any 2xx status is a success and the response is decoded into obj if present
+func (c *Client) v1Command(ctx context.Context, name, method, ep string, body io.Reader, contentType string, obj interface{}, query url.Values) (*RateLimit, error) {
+	req, err := http.NewRequestWithContext(ctx, method, ep, body)
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", name, err)
+	}
+	if len(contentType) > 0 {
+		req.Header.Add("Content-Type", contentType)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+	if len(query) > 0 {
+		req.URL.RawQuery = query.Encode()
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s response: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	if obj == nil {
+		return rl, nil
+	}
+	if err := decoder.Decode(obj); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      name,
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+	return rl, nil
+}
+
+// CreateMediaMetadata will add the alt text to the uploaded media
+func (c *Client) CreateMediaMetadata(ctx context.Context, metadata CreateMediaMetadataRequest) (*CreateMediaMetadataResponse, error) {
+	if err := metadata.validate(); err != nil {
+		return nil, fmt.Errorf("create media metadata: %w", err)
+	}
+	request := struct {
+		MediaID string `json:"media_id"`
+		AltText struct {
+			Text string `json:"text"`
+		} `json:"alt_text"`
+	}{
+		MediaID: metadata.MediaID,
+	}
+	request.AltText.Text = metadata.AltText
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("create media metadata marshal error %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, mediaMetadataEndpoint.url(c.uploadHost()), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create media metadata request: %w", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	c.Authorizer.Add(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("create media metadata response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		e := &ErrorResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	return &CreateMediaMetadataResponse{
+		RateLimit: rl,
+	}, nil
+}
+
+// ComplianceBatchWorkflow will run a compliance batch job from start to finish.  The job is created, the ids are uploaded,
+// the job is polled until it is complete and each of the results are sent to the handler.  If the upload URL has expired,
+// a new job is created.  If there is a checkpoint, a job that was uploaded before a restart is resumed.
+func (c *Client) ComplianceBatchWorkflow(ctx context.Context, jobType ComplianceBatchJobType, ids ComplianceBatchIDSource, handler ComplianceBatchResultHandler, opts ComplianceBatchWorkflowOpts) (*ComplianceBatchWorkflowResponse, error) {
+	switch {
+	case len(jobType) == 0:
+		return nil, fmt.Errorf("compliance batch workflow: a type is required: %w", ErrParameter)
+	case ids == nil:
+		return nil, fmt.Errorf("compliance batch workflow: an id source is required: %w", ErrParameter)
+	case handler == nil:
+		return nil, fmt.Errorf("compliance batch workflow: a result handler is required: %w", ErrParameter)
+	default:
+	}
+	if opts.MinPollInterval <= 0 {
+		opts.MinPollInterval = complianceBatchWorkflowMinPoll
+	}
+	if opts.MaxPollInterval < opts.MinPollInterval {
+		opts.MaxPollInterval = complianceBatchWorkflowMaxPoll
+		if opts.MaxPollInterval < opts.MinPollInterval {
+			opts.MaxPollInterval = opts.MinPollInterval
+		}
+	}
+	if opts.UploadRetries <= 0 {
+		opts.UploadRetries = complianceBatchWorkflowUploadRetries
+	}
+
+	workflow := &complianceBatchWorkflow{
+		client:  c,
+		jobType: jobType,
+		opts:    opts,
+		now:     time.Now,
+	}
+	return workflow.run(ctx, ids, handler)
+}
+
+// UsageTweets will return the number of tweets consumed by the project for the current billing period, with the
+// daily usage of the project and each of the client apps
+func (c *Client) UsageTweets(ctx context.Context, opts UsageTweetsOpts) (*UsageTweetsResponse, error) {
+	switch {
+	case opts.Days != 0 && (opts.Days < usageTweetsMinDays || opts.Days > usageTweetsMaxDays):
+		return nil, fmt.Errorf("usage tweets: days [%d] must be between %d and %d: %w", opts.Days, usageTweetsMinDays, usageTweetsMaxDays, ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, usageTweetsEndpoint.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("usage tweets request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("usage tweets response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != http.StatusOK {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	raw := &UsageTweetsRaw{}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      "usage tweets",
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+	return &UsageTweetsResponse{
+		Raw:       raw,
+		RateLimit: rl,
+	}, nil
+}
+
+// CreateWebhook will register the webhook URL with the account activity environment.  Twitter will send a challenge
+// response check to the URL before the webhook is registered.
+func (c *Client) CreateWebhook(ctx context.Context, env, webhookURL string) (*CreateWebhookResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("create webhook: an environment is required: %w", ErrParameter)
+	case len(webhookURL) == 0:
+		return nil, fmt.Errorf("create webhook: an url is required: %w", ErrParameter)
+	default:
+	}
+
+	webhook := &WebhookObj{}
+	rl, err := c.v1Command(ctx, "create webhook", http.MethodPost, accountActivityWebhooksEndpoint.urlEnv(c.Host, env, ""), nil, "", webhook, url.Values{"url": {webhookURL}})
+	if err != nil {
+		return nil, err
+	}
+	return &CreateWebhookResponse{
+		Webhook:   webhook,
+		RateLimit: rl,
+	}, nil
+}
+
+// WebhookLookup will return the webhooks registered with the account activity environment
+func (c *Client) WebhookLookup(ctx context.Context, env string) (*WebhookLookupResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("webhook lookup: an environment is required: %w", ErrParameter)
+	default:
+	}
+
+	webhooks := []*WebhookObj{}
+	rl, err := c.v1Command(ctx, "webhook lookup", http.MethodGet, accountActivityWebhooksEndpoint.urlEnv(c.Host, env, ""), nil, "", &webhooks, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookLookupResponse{
+		Webhooks:  webhooks,
+		RateLimit: rl,
+	}, nil
+}
+
+// DeleteWebhook will remove the webhook from the account activity environment
+func (c *Client) DeleteWebhook(ctx context.Context, env, webhookID string) (*WebhookManageResponse, error) {
+	return c.webhookManage(ctx, "delete webhook", http.MethodDelete, env, webhookID, accountActivityWebhookEndpoint)
+}
+
+// TriggerWebhookCRC will have twitter send a challenge response check to the webhook, which will re-enable the
+// webhook if it has been marked as not valid
+func (c *Client) TriggerWebhookCRC(ctx context.Context, env, webhookID string) (*WebhookManageResponse, error) {
+	return c.webhookManage(ctx, "trigger webhook crc", http.MethodPut, env, webhookID, accountActivityWebhookEndpoint)
+}
+
+// CreateWebhookSubscription will subscribe the authorized user to the account activity environment, this requires
+// the user context
+func (c *Client) CreateWebhookSubscription(ctx context.Context, env string) (*WebhookManageResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("create webhook subscription: an environment is required: %w", ErrParameter)
+	default:
+	}
+
+	rl, err := c.v1Command(ctx, "create webhook subscription", http.MethodPost, accountActivitySubscriptionsEndpoint.urlEnv(c.Host, env, ""), nil, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookManageResponse{
+		RateLimit: rl,
+	}, nil
+}
+
+// WebhookSubscription will check if the authorized user is subscribed to the account activity environment
+func (c *Client) WebhookSubscription(ctx context.Context, env string) (*WebhookSubscriptionResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("webhook subscription: an environment is required: %w", ErrParameter)
+	default:
+	}
+
+	rl, err := c.v1Command(ctx, "webhook subscription", http.MethodGet, accountActivitySubscriptionsEndpoint.urlEnv(c.Host, env, ""), nil, "", nil, nil)
+	if err != nil {
+		// twitter will return not found when the user is not subscribed
+		errResp := &ErrorResponse{}
+		if errors.As(err, &errResp) && errResp.StatusCode == http.StatusNotFound {
+			return &WebhookSubscriptionResponse{
+				Subscribed: false,
+				RateLimit:  errResp.RateLimit,
+			}, nil
+		}
+		return nil, err
+	}
+	return &WebhookSubscriptionResponse{
+		Subscribed: true,
+		RateLimit:  rl,
+	}, nil
+}
+
+// DeleteWebhookSubscription will remove the user's subscription from the account activity environment
+func (c *Client) DeleteWebhookSubscription(ctx context.Context, env, userID string) (*WebhookManageResponse, error) {
+	return c.webhookManage(ctx, "delete webhook subscription", http.MethodDelete, env, userID, accountActivitySubscriptionEndpoint)
+}
+
+// WebhookSubscriptionsLookup will return the users that are subscribed to the account activity environment
+func (c *Client) WebhookSubscriptionsLookup(ctx context.Context, env string) (*WebhookSubscriptionsLookupResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("webhook subscriptions lookup: an environment is required: %w", ErrParameter)
+	default:
+	}
+
+	subscriptions := &WebhookSubscriptionsObj{}
+	rl, err := c.v1Command(ctx, "webhook subscriptions lookup", http.MethodGet, accountActivitySubscriptionsListEndpoint.urlEnv(c.Host, env, ""), nil, "", subscriptions, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookSubscriptionsLookupResponse{
+		Raw:       subscriptions,
+		RateLimit: rl,
+	}, nil
+}
+
+func (c *Client) webhookManage(ctx context.Context, name, method, env, id string, ep endpoint) (*WebhookManageResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("%s: an environment is required: %w", name, ErrParameter)
+	case len(id) == 0:
+		return nil, fmt.Errorf("%s: an id is required: %w", name, ErrParameter)
+	default:
+	}
+
+	rl, err := c.v1Command(ctx, name, method, ep.urlEnv(c.Host, env, id), nil, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookManageResponse{
+		RateLimit: rl,
+	}, nil
+}
+
+// TrendsByWOEID will return the trends of the location, the location is the Yahoo! where on earth id
+func (c *Client) TrendsByWOEID(ctx context.Context, woeid int, opts TrendsByWOEIDOpts) (*TrendsByWOEIDResponse, error) {
+	switch {
+	case woeid <= 0:
+		return nil, fmt.Errorf("trends by woeid: a woeid is required: %w", ErrParameter)
+	case opts.MaxTrends > trendsMaxTrends:
+		return nil, fmt.Errorf("trends by woeid: a max trends [%d] is required [current: %d]: %w", trendsMaxTrends, opts.MaxTrends, ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, trendsByWOEIDEndpoint.urlID(c.Host, strconv.Itoa(woeid)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("trends by woeid request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.Authorizer.Add(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("trends by woeid response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != http.StatusOK {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:     resp.Status,
+				StatusCode: resp.StatusCode,
+				URL:        resp.Request.URL.String(),
+				RateLimit:  rl,
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		return nil, e
+	}
+
+	raw := &TrendsRaw{}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      "trends by woeid",
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+	return &TrendsByWOEIDResponse{
+		Raw:       raw,
+		RateLimit: rl,
+	}, nil
+}
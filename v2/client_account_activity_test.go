@@ -0,0 +1,164 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_CreateWebhook(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost {
+				log.Panicf("the method is not correct %s %s", req.Method, http.MethodPost)
+			}
+			if req.URL.Path != "/1.1/account_activity/all/dev/webhooks.json" {
+				log.Panicf("the url is not correct %s", req.URL.String())
+			}
+			if req.URL.Query().Get("url") != "https://example.com/webhook" {
+				log.Panicf("the webhook url is not correct %s", req.URL.String())
+			}
+			body := `{
+				"id": "1234567890",
+				"url": "https://example.com/webhook",
+				"valid": true,
+				"created_timestamp": "2016-06-02T23:54:02Z"
+			}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	got, err := c.CreateWebhook(context.Background(), "dev", "https://example.com/webhook")
+	if err != nil {
+		t.Fatalf("Client.CreateWebhook() error = %v", err)
+	}
+	want := &WebhookObj{
+		ID:               "1234567890",
+		URL:              "https://example.com/webhook",
+		Valid:            true,
+		CreatedTimestamp: "2016-06-02T23:54:02Z",
+	}
+	if !reflect.DeepEqual(got.Webhook, want) {
+		t.Errorf("Client.CreateWebhook() = %v, want %v", got.Webhook, want)
+	}
+}
+
+func TestClient_WebhookSubscription(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "subscribed",
+			status: http.StatusNoContent,
+			want:   true,
+		},
+		{
+			name:   "not subscribed",
+			status: http.StatusNotFound,
+			body:   `{"errors":[{"code":34,"message":"Sorry, that page does not exist."}]}`,
+			want:   false,
+		},
+		{
+			name:    "unauthorized",
+			status:  http.StatusUnauthorized,
+			body:    `{"errors":[{"code":32,"message":"Could not authenticate you."}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if req.URL.Path != "/1.1/account_activity/all/dev/subscriptions.json" {
+						log.Panicf("the url is not correct %s", req.URL.String())
+					}
+					return &http.Response{
+						StatusCode: tt.status,
+						Body:       io.NopCloser(strings.NewReader(tt.body)),
+						Header:     http.Header{},
+						Request:    req,
+					}
+				}),
+			}
+			got, err := c.WebhookSubscription(context.Background(), "dev")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Client.WebhookSubscription() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Subscribed != tt.want {
+				t.Errorf("Client.WebhookSubscription() = %v, want %v", got.Subscribed, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_WebhookManage(t *testing.T) {
+	requests := []string{}
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			status, body := http.StatusNoContent, ""
+			if strings.HasSuffix(req.URL.Path, "list.json") {
+				status = http.StatusOK
+				body = `{"environment":"dev","application_id":"13090192","subscriptions":[{"user_id":"3001969357"}]}`
+			}
+			return &http.Response{
+				StatusCode: status,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	if _, err := c.TriggerWebhookCRC(context.Background(), "dev", "1234"); err != nil {
+		t.Fatalf("Client.TriggerWebhookCRC() error = %v", err)
+	}
+	if _, err := c.DeleteWebhook(context.Background(), "dev", "1234"); err != nil {
+		t.Fatalf("Client.DeleteWebhook() error = %v", err)
+	}
+	if _, err := c.CreateWebhookSubscription(context.Background(), "dev"); err != nil {
+		t.Fatalf("Client.CreateWebhookSubscription() error = %v", err)
+	}
+	if _, err := c.DeleteWebhookSubscription(context.Background(), "dev", "3001969357"); err != nil {
+		t.Fatalf("Client.DeleteWebhookSubscription() error = %v", err)
+	}
+	subscriptions, err := c.WebhookSubscriptionsLookup(context.Background(), "dev")
+	if err != nil {
+		t.Fatalf("Client.WebhookSubscriptionsLookup() error = %v", err)
+	}
+	if len(subscriptions.Raw.Subscriptions) != 1 || subscriptions.Raw.Subscriptions[0].UserID != "3001969357" {
+		t.Errorf("Client.WebhookSubscriptionsLookup() = %v", subscriptions.Raw)
+	}
+	if _, err := c.DeleteWebhook(context.Background(), "dev", ""); err == nil {
+		t.Errorf("Client.DeleteWebhook() expected a parameter error")
+	}
+
+	want := []string{
+		"PUT /1.1/account_activity/all/dev/webhooks/1234.json",
+		"DELETE /1.1/account_activity/all/dev/webhooks/1234.json",
+		"POST /1.1/account_activity/all/dev/subscriptions.json",
+		"DELETE /1.1/account_activity/all/dev/subscriptions/3001969357.json",
+		"GET /1.1/account_activity/all/dev/subscriptions/list.json",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("Client webhook requests = %v, want %v", requests, want)
+	}
+}
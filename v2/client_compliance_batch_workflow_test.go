@@ -0,0 +1,190 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type complianceBatchWorkflowServer struct {
+	mutex    sync.Mutex
+	creates  int
+	uploads  []string
+	statuses []ComplianceBatchJobStatus
+	polls    int
+}
+
+func (s *complianceBatchWorkflowServer) job(id string, status ComplianceBatchJobStatus, uploadExpires time.Time) string {
+	return fmt.Sprintf(`{
+		"data": {
+			"id": "%s",
+			"type": "tweets",
+			"status": "%s",
+			"upload_url": "https://upload.test.com/%s",
+			"upload_expires_at": "%s",
+			"download_url": "https://download.test.com/%s",
+			"download_expires_at": "%s"
+		}
+	}`, id, status, id, uploadExpires.Format(time.RFC3339), id, time.Now().Add(time.Hour).Format(time.RFC3339))
+}
+
+func (s *complianceBatchWorkflowServer) handler(req *http.Request) *http.Response {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	body := ""
+	switch {
+	case req.Method == http.MethodPost && strings.Contains(req.URL.String(), string(complianceJobsEndpoint)):
+		s.creates++
+		expires := time.Now().Add(time.Hour)
+		if s.creates == 1 {
+			expires = time.Now().Add(-time.Minute)
+		}
+		body = s.job(fmt.Sprintf("job-%d", s.creates), ComplianceBatchJobStatusCreated, expires)
+	case req.Method == http.MethodGet && strings.Contains(req.URL.String(), string(complianceJobsEndpoint)):
+		id := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+		status := s.statuses[s.polls]
+		if s.polls < len(s.statuses)-1 {
+			s.polls++
+		}
+		body = s.job(id, status, time.Now().Add(time.Hour))
+	case req.Method == http.MethodPut && strings.HasPrefix(req.URL.String(), "https://upload.test.com"):
+		ids, _ := io.ReadAll(req.Body)
+		s.uploads = append(s.uploads, req.URL.Path+" "+strings.TrimSpace(string(ids)))
+	case req.Method == http.MethodGet && strings.HasPrefix(req.URL.String(), "https://download.test.com"):
+		body = `{"id":"1","action":"delete","created_at":"2021-07-06T18:40:40.000Z","redacted_at":"2021-07-06T18:40:40.000Z","reason":"deleted"}` + "\r\n" +
+			`{"id":"2","action":"delete","created_at":"2021-07-06T18:40:40.000Z","redacted_at":"2021-07-06T18:40:40.000Z","reason":"deleted"}` + "\r\n"
+	default:
+		log.Panicf("the request is not correct %s %s", req.Method, req.URL.String())
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{},
+		Request:    req,
+	}
+}
+
+func TestClient_ComplianceBatchWorkflow(t *testing.T) {
+	tests := []struct {
+		name        string
+		checkpoint  *ComplianceBatchCheckpointState
+		statuses    []ComplianceBatchJobStatus
+		wantCreates int
+		wantUploads []string
+		wantResults []string
+		wantResumed bool
+		wantJobErr  bool
+	}{
+		{
+			name:        "upload url expired",
+			statuses:    []ComplianceBatchJobStatus{ComplianceBatchJobStatusInProgress, ComplianceBatchJobStatusComplete},
+			wantCreates: 2,
+			wantUploads: []string{"/job-2 10\n20"},
+			wantResults: []string{"1", "2"},
+		},
+		{
+			name: "resume after upload",
+			checkpoint: &ComplianceBatchCheckpointState{
+				JobID: "job-7",
+				Type:  ComplianceBatchJobTypeTweets,
+			},
+			statuses:    []ComplianceBatchJobStatus{ComplianceBatchJobStatusInProgress, ComplianceBatchJobStatusComplete},
+			wantUploads: []string{},
+			wantResults: []string{"1", "2"},
+			wantResumed: true,
+		},
+		{
+			name:        "job failed",
+			statuses:    []ComplianceBatchJobStatus{ComplianceBatchJobStatusFailed},
+			wantCreates: 2,
+			wantUploads: []string{"/job-2 10\n20"},
+			wantJobErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &complianceBatchWorkflowServer{
+				uploads:  []string{},
+				statuses: tt.statuses,
+			}
+			c := &Client{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client:     mockHTTPClient(server.handler),
+			}
+			checkpoint := ComplianceBatchFileCheckpoint{
+				Path: filepath.Join(t.TempDir(), "checkpoint.json"),
+			}
+			if tt.checkpoint != nil {
+				if err := checkpoint.Save(tt.checkpoint); err != nil {
+					t.Fatalf("ComplianceBatchFileCheckpoint.Save() error = %v", err)
+				}
+			}
+
+			results := []string{}
+			got, err := c.ComplianceBatchWorkflow(context.Background(), ComplianceBatchJobTypeTweets, ComplianceBatchIDsFromReader(strings.NewReader("10\n\n20\n")), func(result *ComplianceBatchJobResult) error {
+				results = append(results, result.ID)
+				return nil
+			}, ComplianceBatchWorkflowOpts{
+				MinPollInterval: time.Millisecond,
+				Checkpoint:      checkpoint,
+			})
+
+			if server.creates != tt.wantCreates {
+				t.Errorf("Client.ComplianceBatchWorkflow() creates = %v, want %v", server.creates, tt.wantCreates)
+			}
+			if !reflect.DeepEqual(server.uploads, tt.wantUploads) {
+				t.Errorf("Client.ComplianceBatchWorkflow() uploads = %q, want %q", server.uploads, tt.wantUploads)
+			}
+			state, loadErr := checkpoint.Load()
+			if loadErr != nil || state != nil {
+				t.Errorf("Client.ComplianceBatchWorkflow() checkpoint = %v, %v, want cleared", state, loadErr)
+			}
+
+			if tt.wantJobErr {
+				jobErr := &ComplianceBatchJobError{}
+				if !errors.As(err, &jobErr) {
+					t.Errorf("Client.ComplianceBatchWorkflow() error = %v, want job error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Client.ComplianceBatchWorkflow() error = %v", err)
+			}
+			if !reflect.DeepEqual(results, tt.wantResults) || got.ResultCount != len(tt.wantResults) {
+				t.Errorf("Client.ComplianceBatchWorkflow() results = %v, want %v", results, tt.wantResults)
+			}
+			if got.Resumed != tt.wantResumed || got.Job.Status != ComplianceBatchJobStatusComplete {
+				t.Errorf("Client.ComplianceBatchWorkflow() job = %+v resumed %v", got.Job, got.Resumed)
+			}
+		})
+	}
+}
+
+func TestComplianceBatchIDsFromSlice(t *testing.T) {
+	source := ComplianceBatchIDsFromSlice([]string{"1", "2"})
+	ids := []string{}
+	for {
+		id, err := source.NextID()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("ComplianceBatchIDsFromSlice() error = %v", err)
+		}
+		ids = append(ids, id)
+	}
+	if !reflect.DeepEqual(ids, []string{"1", "2"}) {
+		t.Errorf("ComplianceBatchIDsFromSlice() = %v", ids)
+	}
+}
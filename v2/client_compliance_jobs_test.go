@@ -348,3 +348,34 @@ func TestClient_ComplianceBatchJobLookup(t *testing.T) {
 		})
 	}
 }
+
+type mockHeaderAuth struct{}
+
+func (m *mockHeaderAuth) Add(req *http.Request) {
+	req.Header.Add("Authorization", "Bearer test")
+}
+
+func TestClient_ComplianceBatchJobAuthorization(t *testing.T) {
+	authorized := 0
+	c := &Client{
+		Authorizer: &mockHeaderAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Header.Get("Authorization") != "Bearer test" {
+				log.Panicf("the authorization is not correct %s %s", req.Method, req.URL.String())
+			}
+			authorized++
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{}}`)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	_, _ = c.CreateComplianceBatchJob(context.Background(), ComplianceBatchJobTypeTweets, CreateComplianceBatchJobOpts{})
+	_, _ = c.ComplianceBatchJob(context.Background(), "1372966999991541762")
+	_, _ = c.ComplianceBatchJobLookup(context.Background(), ComplianceBatchJobTypeTweets, ComplianceBatchJobLookupOpts{})
+	if authorized != 3 {
+		t.Errorf("Client.ComplianceBatchJob requests authorized = %d, want 3", authorized)
+	}
+}
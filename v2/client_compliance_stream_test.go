@@ -0,0 +1,328 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_ComplianceStream(t *testing.T) {
+	eventAt := func(str string) time.Time {
+		t, _ := time.Parse(time.RFC3339, str)
+		return t
+	}
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		opts ComplianceStreamOpts
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		stream     func(*Client) func(context.Context, ComplianceStreamOpts) (*ComplianceStream, error)
+		wantEvents []*ComplianceStreamEvent
+		wantSystem []map[SystemMessageType]SystemMessage
+		wantErr    bool
+	}{
+		{
+			name: "tweet compliance",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), string(tweetComplianceStreamEndpoint)) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), tweetComplianceStreamEndpoint)
+					}
+					if req.URL.Query().Get("partition") != "2" {
+						log.Panicf("the partition is not correct %s", req.URL.Query().Get("partition"))
+					}
+					if req.URL.Query().Get("backfill_minutes") != "3" {
+						log.Panicf("the backfill is not correct %s", req.URL.Query().Get("backfill_minutes"))
+					}
+					stream := `{"data":{"delete":{"tweet":{"id":"1","author_id":"10"},"event_at":"2021-07-06T18:40:40.000Z"}}}`
+					stream += "\r\n"
+					stream += `{"data":{"withheld":{"tweet":{"id":"2","author_id":"10"},"withheld_in_countries":["DE"],"event_at":"2021-07-06T18:40:41.000Z"}}}`
+					stream += "\r\n"
+					stream += `{"info":{"message":"keep going","sent":"2017-01-11T18:12:52+00:00"}}`
+					stream += "\r\n"
+					stream += `{"data":{"scrub_geo":{"user":{"id":"10"},"up_to_tweet_id":"3","event_at":"2021-07-06T18:40:42.000Z"}}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(stream)),
+						Header:     http.Header{},
+					}
+				}),
+			},
+			args: args{
+				opts: ComplianceStreamOpts{
+					Partition:       2,
+					BackfillMinutes: 3,
+				},
+			},
+			stream: func(c *Client) func(context.Context, ComplianceStreamOpts) (*ComplianceStream, error) {
+				return c.TweetComplianceStream
+			},
+			wantEvents: []*ComplianceStreamEvent{
+				{
+					Type:    ComplianceStreamEventDelete,
+					EventAt: eventAt("2021-07-06T18:40:40.000Z"),
+					Tweet: &ComplianceStreamTweetObj{
+						ID:       "1",
+						AuthorID: "10",
+					},
+				},
+				{
+					Type:    ComplianceStreamEventWithheld,
+					EventAt: eventAt("2021-07-06T18:40:41.000Z"),
+					Tweet: &ComplianceStreamTweetObj{
+						ID:       "2",
+						AuthorID: "10",
+					},
+					WithheldInCountries: []string{"DE"},
+				},
+				{
+					Type:    ComplianceStreamEventScrubGeo,
+					EventAt: eventAt("2021-07-06T18:40:42.000Z"),
+					User: &ComplianceStreamUserObj{
+						ID: "10",
+					},
+					UpToTweetID: "3",
+				},
+			},
+			wantSystem: []map[SystemMessageType]SystemMessage{
+				{
+					InfoMessageType: {
+						Message: "keep going",
+						Sent:    eventAt("2017-01-11T18:12:52+00:00"),
+					},
+				},
+			},
+		},
+		{
+			name: "user compliance",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if strings.Contains(req.URL.String(), string(userComplianceStreamEndpoint)) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), userComplianceStreamEndpoint)
+					}
+					stream := `{"data":{"user_protect":{"user":{"id":"10"},"event_at":"2021-07-06T18:40:40.000Z"}}}`
+					stream += "\r\n"
+					stream += `{"data":{"user_suspend":{"user":{"id":"11"},"event_at":"2021-07-06T18:40:41.000Z"}}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(stream)),
+						Header:     http.Header{},
+					}
+				}),
+			},
+			args: args{
+				opts: ComplianceStreamOpts{
+					Partition: 1,
+				},
+			},
+			stream: func(c *Client) func(context.Context, ComplianceStreamOpts) (*ComplianceStream, error) {
+				return c.UserComplianceStream
+			},
+			wantEvents: []*ComplianceStreamEvent{
+				{
+					Type:    ComplianceStreamEventUserProtect,
+					EventAt: eventAt("2021-07-06T18:40:40.000Z"),
+					User: &ComplianceStreamUserObj{
+						ID: "10",
+					},
+				},
+				{
+					Type:    ComplianceStreamEventUserSuspend,
+					EventAt: eventAt("2021-07-06T18:40:41.000Z"),
+					User: &ComplianceStreamUserObj{
+						ID: "11",
+					},
+				},
+			},
+			wantSystem: []map[SystemMessageType]SystemMessage{},
+		},
+		{
+			name: "like compliance",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if strings.Contains(req.URL.String(), string(likeComplianceStreamEndpoint)) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), likeComplianceStreamEndpoint)
+					}
+					stream := `{"data":{"delete":{"favorite":{"id":"5","user_id":"10"},"event_at":"2021-07-06T18:40:40.000Z"}}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(stream)),
+						Header:     http.Header{},
+					}
+				}),
+			},
+			args: args{
+				opts: ComplianceStreamOpts{
+					Partition: 8,
+				},
+			},
+			stream: func(c *Client) func(context.Context, ComplianceStreamOpts) (*ComplianceStream, error) {
+				return c.LikeComplianceStream
+			},
+			wantEvents: []*ComplianceStreamEvent{
+				{
+					Type:    ComplianceStreamEventDelete,
+					EventAt: eventAt("2021-07-06T18:40:40.000Z"),
+					Favorite: &ComplianceStreamFavoriteObj{
+						ID:     "5",
+						UserID: "10",
+					},
+				},
+			},
+			wantSystem: []map[SystemMessageType]SystemMessage{},
+		},
+		{
+			name: "partition required",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent")
+					return nil
+				}),
+			},
+			args: args{
+				opts: ComplianceStreamOpts{},
+			},
+			stream: func(c *Client) func(context.Context, ComplianceStreamOpts) (*ComplianceStream, error) {
+				return c.TweetComplianceStream
+			},
+			wantErr: true,
+		},
+		{
+			name: "partition too large",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent")
+					return nil
+				}),
+			},
+			args: args{
+				opts: ComplianceStreamOpts{
+					Partition: 5,
+				},
+			},
+			stream: func(c *Client) func(context.Context, ComplianceStreamOpts) (*ComplianceStream, error) {
+				return c.UserComplianceStream
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			stream, err := tt.stream(c)(context.Background(), tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.ComplianceStream() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			events := []*ComplianceStreamEvent{}
+			systems := []map[SystemMessageType]SystemMessage{}
+			timer := time.NewTimer(time.Second * 2)
+
+			func() {
+				defer stream.Close()
+				for {
+					select {
+					case sysMsg := <-stream.SystemMessages():
+						systems = append(systems, sysMsg)
+					case event := <-stream.Events():
+						events = append(events, event)
+					case <-timer.C:
+						return
+					case err := <-stream.Err():
+						t.Errorf("Client.ComplianceStream() error %v", err)
+						return
+					}
+				}
+			}()
+
+			if !reflect.DeepEqual(events, tt.wantEvents) {
+				t.Errorf("Client.ComplianceStream() events = %v, want %v", events, tt.wantEvents)
+			}
+			if !reflect.DeepEqual(systems, tt.wantSystem) {
+				t.Errorf("Client.ComplianceStream() systems = %v, want %v", systems, tt.wantSystem)
+			}
+		})
+	}
+}
+
+func TestComplianceStream_slowConsumer(t *testing.T) {
+	frames := []string{}
+	for i := 0; i < 30; i++ {
+		frames = append(frames, fmt.Sprintf(`{"data":{"delete":{"tweet":{"id":"%d","author_id":"10"},"event_at":"2021-07-06T18:40:40.000Z"}}}`, i))
+	}
+	stream := StartComplianceStream(io.NopCloser(strings.NewReader(strings.Join(frames, "\r\n"))))
+
+	// the buffer is full before the events are received
+	time.Sleep(100 * time.Millisecond)
+
+	timer := time.NewTimer(time.Second * 2)
+	for i := 0; i < len(frames); i++ {
+		select {
+		case event := <-stream.Events():
+			if event.Tweet == nil || event.Tweet.ID != fmt.Sprintf("%d", i) {
+				t.Fatalf("ComplianceStream.Events() event %d = %v", i, event)
+			}
+		case <-timer.C:
+			t.Fatalf("ComplianceStream.Events() received %d events, want %d", i, len(frames))
+		}
+	}
+	stream.Close()
+	stream.Close()
+}
+
+func TestComplianceStream_closeBlocked(t *testing.T) {
+	frames := []string{}
+	for i := 0; i < 30; i++ {
+		frames = append(frames, fmt.Sprintf(`{"data":{"delete":{"tweet":{"id":"%d","author_id":"10"},"event_at":"2021-07-06T18:40:40.000Z"}}}`, i))
+	}
+	stream := StartComplianceStream(io.NopCloser(strings.NewReader(strings.Join(frames, "\r\n"))))
+	time.Sleep(100 * time.Millisecond)
+	stream.Close()
+
+	timer := time.NewTimer(time.Second * 2)
+	for {
+		select {
+		case _, ok := <-stream.Events():
+			if !ok {
+				return
+			}
+		case <-timer.C:
+			t.Fatalf("ComplianceStream.Close() the events were not closed")
+		}
+	}
+}
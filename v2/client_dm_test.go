@@ -0,0 +1,418 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_DMEventsLookup(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		opts DMEventsLookupOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *DMEventsLookupResponse
+		wantErr bool
+	}{
+		{
+			name: "Success - with options",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), dmEventsEndpoint.url("")) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), dmEventsEndpoint)
+					}
+					if req.URL.Query().Get("event_types") != "MessageCreate" {
+						log.Panicf("the event types are not correct %s", req.URL.Query().Get("event_types"))
+					}
+					if req.URL.Query().Get("dm_event.fields") != "sender_id,created_at" {
+						log.Panicf("the fields are not correct %s", req.URL.Query().Get("dm_event.fields"))
+					}
+					if req.URL.Query().Get("expansions") != "sender_id" {
+						log.Panicf("the expansions are not correct %s", req.URL.Query().Get("expansions"))
+					}
+					body := `{
+						"data": [
+							{
+								"id": "1580705921830768647",
+								"event_type": "MessageCreate",
+								"text": "Hello",
+								"sender_id": "906948460078698496",
+								"created_at": "2022-10-13T23:08:01.000Z"
+							}
+						],
+						"includes": {
+							"users": [
+								{
+									"id": "906948460078698496",
+									"name": "Developer Support",
+									"username": "TwitterDevSupport"
+								}
+							]
+						},
+						"meta": {
+							"result_count": 1,
+							"next_token": "18LAA581J5II7LA00C00ZZZZ"
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header: func() http.Header {
+							h := http.Header{}
+							h.Add(rateLimit, "15")
+							h.Add(rateRemaining, "12")
+							h.Add(rateReset, "1644461060")
+							return h
+						}(),
+					}
+				}),
+			},
+			args: args{
+				opts: DMEventsLookupOpts{
+					EventTypes:    []DMEventType{DMEventTypeMessageCreate},
+					DMEventFields: []DMEventField{DMEventFieldSenderID, DMEventFieldCreatedAt},
+					Expansions:    []Expansion{ExpansionSenderID},
+				},
+			},
+			want: &DMEventsLookupResponse{
+				Raw: &DMEventsRaw{
+					Events: []*DMEventObj{
+						{
+							ID:        "1580705921830768647",
+							EventType: DMEventTypeMessageCreate,
+							Text:      "Hello",
+							SenderID:  "906948460078698496",
+							CreatedAt: "2022-10-13T23:08:01.000Z",
+						},
+					},
+					Includes: &DMEventRawIncludes{
+						Users: []*UserObj{
+							{
+								ID:       "906948460078698496",
+								Name:     "Developer Support",
+								UserName: "TwitterDevSupport",
+							},
+						},
+					},
+				},
+				Meta: &DMEventsMeta{
+					ResultCount: 1,
+					NextToken:   "18LAA581J5II7LA00C00ZZZZ",
+				},
+				RateLimit: &RateLimit{
+					Limit:     15,
+					Remaining: 12,
+					Reset:     Epoch(1644461060),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "max results",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent")
+					return nil
+				}),
+			},
+			args: args{
+				opts: DMEventsLookupOpts{
+					MaxResults: 101,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.DMEventsLookup(context.Background(), tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DMEventsLookup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DMEventsLookup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DMConversationEventsLookup(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  func(*Client) func(context.Context, string, DMEventsLookupOpts) (*DMEventsLookupResponse, error)
+		ep      endpoint
+		id      string
+		wantErr bool
+	}{
+		{
+			name: "conversation",
+			client: func(c *Client) func(context.Context, string, DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+				return c.DMConversationEventsLookup
+			},
+			ep: dmConversationEventsEndpoint,
+			id: "1346889436626259968",
+		},
+		{
+			name: "participant",
+			client: func(c *Client) func(context.Context, string, DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+				return c.DMParticipantEventsLookup
+			},
+			ep: dmParticipantEventsEndpoint,
+			id: "906948460078698496",
+		},
+		{
+			name: "id required",
+			client: func(c *Client) func(context.Context, string, DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+				return c.DMParticipantEventsLookup
+			},
+			ep:      dmParticipantEventsEndpoint,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if strings.Contains(req.URL.String(), tt.ep.urlID("", tt.id)) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), tt.ep)
+					}
+					if req.URL.Query().Get("pagination_token") != "next" {
+						log.Panicf("the pagination token is not correct %s", req.URL.Query().Get("pagination_token"))
+					}
+					body := `{
+						"data": [
+							{
+								"id": "1580705921830768648",
+								"event_type": "ParticipantsJoin",
+								"dm_conversation_id": "1346889436626259968",
+								"participant_ids": ["906948460078698496"]
+							}
+						],
+						"meta": {
+							"result_count": 1
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     http.Header{},
+					}
+				}),
+			}
+			got, err := tt.client(c)(context.Background(), tt.id, DMEventsLookupOpts{PaginationToken: "next"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DMConversationEventsLookup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			want := []*DMEventObj{
+				{
+					ID:             "1580705921830768648",
+					EventType:      DMEventTypeParticipantsJoin,
+					ConversationID: "1346889436626259968",
+					ParticipantIDs: []string{"906948460078698496"},
+				},
+			}
+			if !reflect.DeepEqual(got.Raw.Events, want) {
+				t.Errorf("Client.DMConversationEventsLookup() = %v, want %v", got.Raw.Events, want)
+			}
+		})
+	}
+}
+
+func TestClient_CreateDMParticipantMessage(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		participantID string
+		message       CreateDMMessage
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *CreateDMMessageResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodPost {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodPost)
+					}
+					if strings.Contains(req.URL.String(), dmParticipantMessagesEndpoint.urlID("", "906948460078698496")) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), dmParticipantMessagesEndpoint)
+					}
+					message := CreateDMMessage{}
+					if err := json.NewDecoder(req.Body).Decode(&message); err != nil {
+						log.Panicf("the body is not correct %v", err)
+					}
+					if message.Text != "hello" || message.Attachments[0].MediaID != "1455952740635586573" {
+						log.Panicf("the message is not correct %v", message)
+					}
+					body := `{
+						"data": {
+							"dm_conversation_id": "1346889436626259968",
+							"dm_event_id": "128341038123"
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusCreated,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header: func() http.Header {
+							h := http.Header{}
+							h.Add(rateLimit, "15")
+							h.Add(rateRemaining, "12")
+							h.Add(rateReset, "1644461060")
+							return h
+						}(),
+					}
+				}),
+			},
+			args: args{
+				participantID: "906948460078698496",
+				message: CreateDMMessage{
+					Text: "hello",
+					Attachments: []CreateDMAttachment{
+						{
+							MediaID: "1455952740635586573",
+						},
+					},
+				},
+			},
+			want: &CreateDMMessageResponse{
+				Data: &DMConversationObj{
+					ID:      "1346889436626259968",
+					EventID: "128341038123",
+				},
+				RateLimit: &RateLimit{
+					Limit:     15,
+					Remaining: 12,
+					Reset:     Epoch(1644461060),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "message required",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent")
+					return nil
+				}),
+			},
+			args: args{
+				participantID: "906948460078698496",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.CreateDMParticipantMessage(context.Background(), tt.args.participantID, tt.args.message)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CreateDMParticipantMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CreateDMParticipantMessage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CreateDMGroupConversation(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost {
+				log.Panicf("the method is not correct %s %s", req.Method, http.MethodPost)
+			}
+			if strings.Contains(req.URL.String(), dmConversationsEndpoint.url("")) == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), dmConversationsEndpoint)
+			}
+			request := map[string]interface{}{}
+			if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+				log.Panicf("the body is not correct %v", err)
+			}
+			if request["conversation_type"] != "Group" {
+				log.Panicf("the conversation type is not correct %v", request["conversation_type"])
+			}
+			body := `{
+				"data": {
+					"dm_conversation_id": "1346889436626259968",
+					"dm_event_id": "128341038123"
+				}
+			}`
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	got, err := c.CreateDMGroupConversation(context.Background(), CreateDMGroupConversationRequest{
+		ParticipantIDs: []string{"944480690", "906948460078698496"},
+		Message: CreateDMMessage{
+			Text: "hello group",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Client.CreateDMGroupConversation() error = %v", err)
+	}
+	want := &DMConversationObj{
+		ID:      "1346889436626259968",
+		EventID: "128341038123",
+	}
+	if !reflect.DeepEqual(got.Data, want) {
+		t.Errorf("Client.CreateDMGroupConversation() = %v, want %v", got.Data, want)
+	}
+
+	if _, err := c.CreateDMGroupConversation(context.Background(), CreateDMGroupConversationRequest{Message: CreateDMMessage{Text: "hello"}}); err == nil {
+		t.Errorf("Client.CreateDMGroupConversation() want participant error")
+	}
+}
@@ -452,10 +452,12 @@ func TestClient_UserLikesLookup(t *testing.T) {
 			args: args{
 				userID: "2244994945",
 				opts: UserLikesLookupOpts{
-					TweetFields: []TweetField{TweetFieldCreatedAt, TweetFieldAuthorID, TweetFieldConversationID, TweetFieldPublicMetrics, TweetFieldContextAnnotations},
-					UserFields:  []UserField{UserFieldUserName},
-					Expansions:  []Expansion{ExpansionAuthorID},
-					MaxResults:  10,
+					TweetSelection: TweetSelection{
+						Expansions:  []Expansion{ExpansionAuthorID},
+						TweetFields: []TweetField{TweetFieldCreatedAt, TweetFieldAuthorID, TweetFieldConversationID, TweetFieldPublicMetrics, TweetFieldContextAnnotations},
+						UserFields:  []UserField{UserFieldUserName},
+					},
+					MaxResults: 10,
 				},
 			},
 			want: &UserLikesLookupResponse{
@@ -451,8 +451,10 @@ func TestClient_ListTweetLookup(t *testing.T) {
 			args: args{
 				listID: "list-1234",
 				opts: ListTweetLookupOpts{
-					Expansions: []Expansion{ExpansionAuthorID},
-					UserFields: []UserField{UserFieldVerified},
+					TweetSelection: TweetSelection{
+						Expansions: []Expansion{ExpansionAuthorID},
+						UserFields: []UserField{UserFieldVerified},
+					},
 				},
 			},
 			want: &ListTweetLookupResponse{
@@ -0,0 +1,267 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_UploadMedia(t *testing.T) {
+	type args struct {
+		media string
+		opts  MediaUploadOpts
+	}
+	tests := []struct {
+		name         string
+		args         args
+		finalize     string
+		status       string
+		wantSegments []string
+		wantCommands []string
+		want         *MediaUploadObj
+		wantErr      bool
+	}{
+		{
+			name: "image",
+			args: args{
+				media: "0123456789",
+				opts: MediaUploadOpts{
+					MediaType:     "image/png",
+					MediaCategory: MediaCategoryTweetImage,
+					ChunkSize:     4,
+					AltText:       "a picture",
+				},
+			},
+			finalize:     `{"media_id_string":"710511363345354753","size":10,"expires_after_secs":86400}`,
+			wantSegments: []string{"0123", "4567", "89"},
+			wantCommands: []string{"INIT", "APPEND", "APPEND", "APPEND", "FINALIZE", "METADATA"},
+			want: &MediaUploadObj{
+				ID:               "710511363345354753",
+				Size:             10,
+				ExpiresAfterSecs: 86400,
+			},
+		},
+		{
+			name: "video processing",
+			args: args{
+				media: "0123456789",
+				opts: MediaUploadOpts{
+					MediaType:     "video/mp4",
+					MediaCategory: MediaCategoryTweetVideo,
+				},
+			},
+			finalize:     `{"media_id_string":"710511363345354753","processing_info":{"state":"pending","check_after_secs":0}}`,
+			status:       `{"media_id_string":"710511363345354753","processing_info":{"state":"succeeded","progress_percent":100}}`,
+			wantSegments: []string{"0123456789"},
+			wantCommands: []string{"INIT", "APPEND", "FINALIZE", "STATUS"},
+			want: &MediaUploadObj{
+				ID: "710511363345354753",
+				ProcessingInfo: &MediaProcessingInfoObj{
+					State:           MediaProcessingStateSucceeded,
+					ProgressPercent: 100,
+				},
+			},
+		},
+		{
+			name: "video processing failed",
+			args: args{
+				media: "0123456789",
+				opts: MediaUploadOpts{
+					MediaType:     "video/mp4",
+					MediaCategory: MediaCategoryTweetVideo,
+				},
+			},
+			finalize:     `{"media_id_string":"710511363345354753","processing_info":{"state":"in_progress","check_after_secs":0}}`,
+			status:       `{"media_id_string":"710511363345354753","processing_info":{"state":"failed","error":{"code":1,"name":"InvalidMedia","message":"Unsupported video format"}}}`,
+			wantSegments: []string{"0123456789"},
+			wantCommands: []string{"INIT", "APPEND", "FINALIZE", "STATUS"},
+			wantErr:      true,
+		},
+		{
+			name: "media type required",
+			args: args{
+				media: "0123456789",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutex := sync.Mutex{}
+			commands := []string{}
+			segments := []string{}
+			c := &Client{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				UploadHost: "https://upload.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					mutex.Lock()
+					defer mutex.Unlock()
+
+					if strings.HasPrefix(req.URL.String(), "https://upload.test.com") == false {
+						log.Panicf("the upload host is not correct %s", req.URL.String())
+					}
+					body := ""
+					switch {
+					case strings.Contains(req.URL.String(), string(mediaMetadataEndpoint)):
+						request := map[string]interface{}{}
+						if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+							log.Panicf("the metadata is not correct %v", err)
+						}
+						if request["media_id"] != "710511363345354753" {
+							log.Panicf("the metadata media id is not correct %v", request)
+						}
+						commands = append(commands, "METADATA")
+					case req.Method == http.MethodGet:
+						if req.URL.Query().Get("media_id") != "710511363345354753" {
+							log.Panicf("the status media id is not correct %s", req.URL.Query().Get("media_id"))
+						}
+						commands = append(commands, req.URL.Query().Get("command"))
+						body = tt.status
+					case strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data"):
+						file, _, err := req.FormFile("media")
+						if err != nil {
+							log.Panicf("the append media is not correct %v", err)
+						}
+						chunk, _ := io.ReadAll(file)
+						segments = append(segments, string(chunk))
+						commands = append(commands, req.FormValue("command"))
+						return &http.Response{
+							StatusCode: http.StatusNoContent,
+							Body:       io.NopCloser(strings.NewReader("")),
+							Header:     http.Header{},
+						}
+					default:
+						if err := req.ParseForm(); err != nil {
+							log.Panicf("the form is not correct %v", err)
+						}
+						commands = append(commands, req.PostForm.Get("command"))
+						switch req.PostForm.Get("command") {
+						case "INIT":
+							if req.PostForm.Get("total_bytes") != "10" || req.PostForm.Get("media_category") != string(tt.args.opts.MediaCategory) {
+								log.Panicf("the init is not correct %v", req.PostForm)
+							}
+							body = `{"media_id_string":"710511363345354753","expires_after_secs":86400}`
+						case "FINALIZE":
+							body = tt.finalize
+						}
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     http.Header{},
+					}
+				}),
+			}
+			got, err := c.UploadMedia(context.Background(), strings.NewReader(tt.args.media), tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.UploadMedia() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(commands, tt.wantCommands) && len(tt.wantCommands) > 0 {
+				t.Errorf("Client.UploadMedia() commands = %v, want %v", commands, tt.wantCommands)
+			}
+			if !reflect.DeepEqual(segments, tt.wantSegments) && len(tt.wantSegments) > 0 {
+				t.Errorf("Client.UploadMedia() segments = %v, want %v", segments, tt.wantSegments)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Media, tt.want) {
+				t.Errorf("Client.UploadMedia() = %v, want %v", got.Media, tt.want)
+			}
+			if !reflect.DeepEqual(got.TweetMedia().IDs, []string{tt.want.ID}) {
+				t.Errorf("Client.UploadMedia() tweet media = %v", got.TweetMedia())
+			}
+		})
+	}
+}
+
+// mediaErrReader returns the data with the error, then EOF
+type mediaErrReader struct {
+	data string
+	err  error
+}
+
+func (m *mediaErrReader) Read(p []byte) (int, error) {
+	n := copy(p, m.data)
+	m.data = m.data[n:]
+	err := m.err
+	m.err = io.EOF
+	return n, err
+}
+
+func TestClient_UploadMedia_readError(t *testing.T) {
+	readErr := errors.New("disk error")
+	appends := 0
+	c := &Client{
+		Authorizer: &mockAuth{},
+		UploadHost: "https://upload.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			body := `{"media_id_string":"710511363345354753"}`
+			if strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data") {
+				appends++
+				body = ""
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	media := &mediaErrReader{
+		data: "01",
+		err:  readErr,
+	}
+	_, err := c.UploadMedia(context.Background(), media, MediaUploadOpts{
+		MediaType:  "image/png",
+		TotalBytes: 10,
+		ChunkSize:  4,
+	})
+	if !errors.Is(err, readErr) {
+		t.Errorf("Client.UploadMedia() error = %v, want %v", err, readErr)
+	}
+	if appends != 1 {
+		t.Errorf("Client.UploadMedia() appends = %d, want 1", appends)
+	}
+}
+
+func TestMediaProcessingInfoObj_checkAfter(t *testing.T) {
+	tests := []struct {
+		name           string
+		checkAfterSecs int
+		want           time.Duration
+	}{
+		{
+			name: "missing",
+			want: mediaUploadMinCheckAfter,
+		},
+		{
+			name:           "negative",
+			checkAfterSecs: -1,
+			want:           mediaUploadMinCheckAfter,
+		},
+		{
+			name:           "set",
+			checkAfterSecs: 5,
+			want:           5 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := &MediaProcessingInfoObj{CheckAfterSecs: tt.checkAfterSecs}
+			if got := info.checkAfter(); got != tt.want {
+				t.Errorf("MediaProcessingInfoObj.checkAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -617,8 +617,10 @@ func TestClient_SpaceTweetsLookup(t *testing.T) {
 			args: args{
 				spaceID: "1DXxyRYNejbKM",
 				opts: SpaceTweetsLookupOpts{
-					Expansions: []Expansion{ExpansionAuthorID},
-					UserFields: []UserField{UserFieldCreatedAt, UserFieldDescription},
+					TweetSelection: TweetSelection{
+						Expansions: []Expansion{ExpansionAuthorID},
+						UserFields: []UserField{UserFieldCreatedAt, UserFieldDescription},
+					},
 				},
 			},
 			want: &SpaceTweetsLookupResponse{
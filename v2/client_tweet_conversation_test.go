@@ -0,0 +1,192 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_TweetConversation(t *testing.T) {
+	client := mockHTTPClient(func(req *http.Request) *http.Response {
+		var body string
+		switch {
+		case strings.Contains(req.URL.Path, string(tweetRecentSearchEndpoint)):
+			if req.URL.Query().Get("query") != "conversation_id:1" {
+				log.Panicf("the query is not correct %s", req.URL.String())
+			}
+			if !strings.Contains(req.URL.Query().Get("tweet.fields"), "referenced_tweets") {
+				log.Panicf("the tweet fields are not correct %s", req.URL.String())
+			}
+			switch req.URL.Query().Get("next_token") {
+			case "":
+				body = `{
+					"data": [
+						{"id": "3", "text": "reply to 2", "conversation_id": "1", "referenced_tweets": [{"type": "replied_to", "id": "2"}]},
+						{"id": "2", "text": "reply to 1", "conversation_id": "1", "author_id": "20", "referenced_tweets": [{"type": "replied_to", "id": "1"}]}
+					],
+					"includes": {
+						"users": [{"id": "20", "name": "Replier", "username": "replier"}]
+					},
+					"meta": {"result_count": 2, "next_token": "page2"}
+				}`
+			case "page2":
+				body = `{
+					"data": [
+						{"id": "6", "text": "reply to 8", "conversation_id": "1", "referenced_tweets": [{"type": "replied_to", "id": "8"}]},
+						{"id": "5", "text": "reply to 9", "conversation_id": "1", "referenced_tweets": [{"type": "replied_to", "id": "9"}]},
+						{"id": "4", "text": "reply to 1", "conversation_id": "1", "referenced_tweets": [{"type": "replied_to", "id": "1"}, {"type": "quoted", "id": "3"}]}
+					],
+					"meta": {"result_count": 3}
+				}`
+			default:
+				log.Panicf("the next token is not correct %s", req.URL.String())
+			}
+		case strings.Contains(req.URL.Path, string(tweetLookupEndpoint)):
+			if req.URL.Query().Get("ids") != "1,8,9" {
+				log.Panicf("the lookup ids are not correct %s", req.URL.String())
+			}
+			body = `{
+				"data": [
+					{"id": "1", "text": "root", "conversation_id": "1"}
+				],
+				"errors": [
+					{"value": "8", "detail": "Sorry, you are not authorized to see the Tweet with ids: [8].", "title": "Authorization Error", "resource_type": "tweet", "parameter": "ids", "type": "https://api.twitter.com/2/problems/not-authorized-for-resource"},
+					{"value": "9", "detail": "Could not find tweet with ids: [9].", "title": "Not Found Error", "resource_type": "tweet", "parameter": "ids", "type": "https://api.twitter.com/2/problems/resource-not-found"}
+				]
+			}`
+		default:
+			log.Panicf("the url is not correct %s", req.URL.String())
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header: func() http.Header {
+				h := http.Header{}
+				h.Add(rateLimit, "450")
+				h.Add(rateRemaining, "440")
+				h.Add(rateReset, "1644461060")
+				return h
+			}(),
+		}
+	})
+
+	type node struct {
+		id    string
+		depth int
+		gap   TweetConversationGap
+	}
+	flatten := func(root *TweetConversationNode) []node {
+		nodes := []node{}
+		root.Walk(func(n *TweetConversationNode) bool {
+			nodes = append(nodes, node{id: n.ID, depth: n.Depth, gap: n.Gap})
+			return true
+		})
+		return nodes
+	}
+
+	tests := []struct {
+		name string
+		opts TweetConversationOpts
+		want []node
+	}{
+		{
+			name: "oldest",
+			want: []node{
+				{id: "1"},
+				{id: "2", depth: 1},
+				{id: "3", depth: 2},
+				{id: "4", depth: 1},
+				{id: "8", depth: 1, gap: TweetConversationGapHidden},
+				{id: "6", depth: 2},
+				{id: "9", depth: 1, gap: TweetConversationGapDeleted},
+				{id: "5", depth: 2},
+			},
+		},
+		{
+			name: "newest",
+			opts: TweetConversationOpts{
+				Order: TweetConversationOrderNewest,
+			},
+			want: []node{
+				{id: "1"},
+				{id: "9", depth: 1, gap: TweetConversationGapDeleted},
+				{id: "5", depth: 2},
+				{id: "8", depth: 1, gap: TweetConversationGapHidden},
+				{id: "6", depth: 2},
+				{id: "4", depth: 1},
+				{id: "2", depth: 1},
+				{id: "3", depth: 2},
+			},
+		},
+		{
+			name: "most replies",
+			opts: TweetConversationOpts{
+				Order: TweetConversationOrderMostReplies,
+			},
+			want: []node{
+				{id: "1"},
+				{id: "2", depth: 1},
+				{id: "3", depth: 2},
+				{id: "8", depth: 1, gap: TweetConversationGapHidden},
+				{id: "6", depth: 2},
+				{id: "9", depth: 1, gap: TweetConversationGapDeleted},
+				{id: "5", depth: 2},
+				{id: "4", depth: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: &mockAuth{},
+				Client:     client,
+				Host:       "https://www.test.com",
+			}
+			got, err := c.TweetConversation(context.Background(), "1", tt.opts)
+			if err != nil {
+				t.Fatalf("Client.TweetConversation() error = %v", err)
+			}
+			if nodes := flatten(got.Root); !reflect.DeepEqual(nodes, tt.want) {
+				t.Errorf("Client.TweetConversation() = %v, want %v", nodes, tt.want)
+			}
+			if len(got.Root.Gaps()) != 2 || got.Root.Size() != 8 {
+				t.Errorf("Client.TweetConversation() gaps = %d size = %d", len(got.Root.Gaps()), got.Root.Size())
+			}
+			if author := got.Root.Replies[0].Dictionary; tt.opts.Order != TweetConversationOrderNewest && (author == nil || author.Author == nil || author.Author.UserName != "replier") {
+				t.Errorf("Client.TweetConversation() reply dictionary = %v", author)
+			}
+		})
+	}
+}
+
+func TestTweetConversationNode_MarshalJSON(t *testing.T) {
+	root := &TweetConversationNode{
+		ID: "1",
+		Dictionary: &TweetDictionary{
+			Tweet:  TweetObj{ID: "1", Text: "root"},
+			Author: &UserObj{ID: "10", Name: "Author", UserName: "author"},
+		},
+		Replies: []*TweetConversationNode{
+			{
+				ID:    "2",
+				Depth: 1,
+				Gap:   TweetConversationGapDeleted,
+			},
+		},
+	}
+	root.Replies[0].Parent = root
+
+	enc, err := json.Marshal(root)
+	if err != nil {
+		t.Fatalf("TweetConversationNode.MarshalJSON() error = %v", err)
+	}
+	want := `{"id":"1","depth":0,"tweet":{"id":"1","text":"root"},"author":{"id":"10","name":"Author","username":"author"},"replies":[{"id":"2","depth":1,"gap":"deleted"}]}`
+	if string(enc) != want {
+		t.Errorf("TweetConversationNode.MarshalJSON() = %s, want %s", enc, want)
+	}
+}
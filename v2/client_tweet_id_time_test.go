@@ -0,0 +1,91 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_TweetRecentSearch_IDTimes(t *testing.T) {
+	since := time.Date(2021, time.November, 15, 18, 0, 0, 0, time.UTC)
+	until := since.Add(time.Hour)
+	tests := []struct {
+		name    string
+		opts    TweetRecentSearchOpts
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "times",
+			opts: TweetRecentSearchOpts{
+				SinceIDTime: since,
+				UntilIDTime: until,
+			},
+			want: map[string]string{
+				"since_id": "1460306603013046271",
+				"until_id": "1460321702507446272",
+			},
+		},
+		{
+			name: "ids take precedence",
+			opts: TweetRecentSearchOpts{
+				SinceID:     "1460323737035677698",
+				UntilIDTime: until,
+			},
+			want: map[string]string{
+				"since_id": "1460323737035677698",
+				"until_id": "1460321702507446272",
+			},
+		},
+		{
+			name: "id and time",
+			opts: TweetRecentSearchOpts{
+				SinceID:     "1460323737035677698",
+				SinceIDTime: since,
+			},
+			wantErr: true,
+		},
+		{
+			name: "before the epoch",
+			opts: TweetRecentSearchOpts{
+				UntilIDTime: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if tt.wantErr {
+						log.Panicf("the request should not be made %s", req.URL.String())
+					}
+					for k, v := range tt.want {
+						if req.URL.Query().Get(k) != v {
+							log.Panicf("the query %s is not correct %s %s", k, req.URL.Query().Get(k), v)
+						}
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"data":[],"meta":{"result_count":0}}`)),
+						Header:     http.Header{},
+					}
+				}),
+			}
+			_, err := c.TweetRecentSearch(context.Background(), "golang", tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Client.TweetRecentSearch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, ErrParameter) {
+				t.Errorf("Client.TweetRecentSearch() error = %v, want %v", err, ErrParameter)
+			}
+		})
+	}
+}
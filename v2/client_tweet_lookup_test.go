@@ -117,8 +117,10 @@ func TestClient_TweetLookup(t *testing.T) {
 			args: args{
 				ids: []string{"1067094924124872705"},
 				opts: TweetLookupOpts{
-					Expansions:  []Expansion{ExpansionAttachmentsMediaKeys},
-					MediaFields: []MediaField{MediaFieldType, MediaFieldDurationMS},
+					TweetSelection: TweetSelection{
+						Expansions:  []Expansion{ExpansionAttachmentsMediaKeys},
+						MediaFields: []MediaField{MediaFieldType, MediaFieldDurationMS},
+					},
 				},
 			},
 			want: &TweetLookupResponse{
@@ -247,9 +249,11 @@ func TestClient_TweetLookup(t *testing.T) {
 			args: args{
 				ids: []string{"1261326399320715264", "1278347468690915330"},
 				opts: TweetLookupOpts{
-					Expansions:  []Expansion{ExpansionAuthorID},
-					TweetFields: []TweetField{TweetFieldCreatedAt},
-					UserFields:  []UserField{UserFieldName, UserFieldVerified},
+					TweetSelection: TweetSelection{
+						Expansions:  []Expansion{ExpansionAuthorID},
+						TweetFields: []TweetField{TweetFieldCreatedAt},
+						UserFields:  []UserField{UserFieldName, UserFieldVerified},
+					},
 				},
 			},
 			want: &TweetLookupResponse{
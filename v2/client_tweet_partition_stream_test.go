@@ -0,0 +1,221 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_TweetFirehoseStream(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		opts TweetPartitionStreamOpts
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantTweet []*TweetMessage
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), string(tweetFirehoseStreamEndpoint)) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), tweetFirehoseStreamEndpoint)
+					}
+					if req.URL.Query().Get("partition") != "12" {
+						log.Panicf("the partition is not correct %s", req.URL.Query().Get("partition"))
+					}
+					if req.URL.Query().Get("backfill_minutes") != "2" {
+						log.Panicf("the backfill is not correct %s", req.URL.Query().Get("backfill_minutes"))
+					}
+					stream := `{"data":{"id":"1","text":"hello"}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(stream)),
+						Header:     http.Header{},
+					}
+				}),
+			},
+			args: args{
+				opts: TweetPartitionStreamOpts{
+					Partition:       12,
+					BackfillMinutes: 2,
+				},
+			},
+			wantTweet: []*TweetMessage{
+				{
+					Raw: &TweetRaw{
+						Tweets: []*TweetObj{
+							{
+								ID:   "1",
+								Text: "hello",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "partition required",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent")
+					return nil
+				}),
+			},
+			args: args{
+				opts: TweetPartitionStreamOpts{
+					Partition: 21,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			stream, err := c.TweetFirehoseStream(context.Background(), tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.TweetFirehoseStream() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			tweets := []*TweetMessage{}
+			timer := time.NewTimer(time.Second)
+			func() {
+				defer stream.Close()
+				for {
+					select {
+					case tweetMsg := <-stream.Tweets():
+						tweets = append(tweets, tweetMsg)
+					case <-timer.C:
+						return
+					case err := <-stream.Err():
+						t.Errorf("Client.TweetFirehoseStream() error %v", err)
+						return
+					}
+				}
+			}()
+			if !reflect.DeepEqual(tweets, tt.wantTweet) {
+				t.Errorf("Client.TweetFirehoseStream() tweets = %v, want %v", tweets, tt.wantTweet)
+			}
+		})
+	}
+}
+
+func TestClient_TweetPartitionedStream(t *testing.T) {
+	mutex := sync.Mutex{}
+	requests := map[string][]string{}
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.String(), string(tweetSample10StreamEndpoint)) == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), tweetSample10StreamEndpoint)
+			}
+			partition := req.URL.Query().Get("partition")
+
+			mutex.Lock()
+			requests[partition] = append(requests[partition], req.URL.Query().Get("backfill_minutes"))
+			attempt := len(requests[partition])
+			mutex.Unlock()
+
+			if partition == "1" && attempt == 1 {
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Body:       io.NopCloser(strings.NewReader("unavailable")),
+					Header:     http.Header{},
+					Request:    req,
+				}
+			}
+			stream := `{"data":{"id":"` + partition + `","text":"hello"}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(stream)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+
+	stream, err := c.TweetPartitionedStream(context.Background(), TweetPartitionStreamSample10, TweetPartitionedStreamOpts{
+		ReconnectBackfillMinutes: 5,
+		MinBackoff:               10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Client.TweetPartitionedStream() error = %v", err)
+	}
+
+	ids := []string{}
+	errs := 0
+	timer := time.NewTimer(time.Second)
+	func() {
+		for {
+			select {
+			case tweetMsg := <-stream.Tweets():
+				ids = append(ids, tweetMsg.Raw.Tweets[0].ID)
+			case <-stream.Err():
+				errs++
+			case <-timer.C:
+				return
+			}
+		}
+	}()
+	health := stream.Health()
+	stream.Close()
+
+	sort.Strings(ids)
+	if !reflect.DeepEqual(ids, []string{"1", "2"}) {
+		t.Errorf("Client.TweetPartitionedStream() tweets = %v, want %v", ids, []string{"1", "2"})
+	}
+	if errs != 1 {
+		t.Errorf("Client.TweetPartitionedStream() errors = %v, want %v", errs, 1)
+	}
+	if len(health) != 2 {
+		t.Fatalf("Client.TweetPartitionedStream() health = %v", health)
+	}
+	if health[0].Partition != 1 || health[0].Reconnects != 1 || !health[0].Connected || health[0].LastError == nil {
+		t.Errorf("Client.TweetPartitionedStream() partition 1 health = %+v", health[0])
+	}
+	if health[1].Partition != 2 || health[1].Reconnects != 0 || !health[1].Connected {
+		t.Errorf("Client.TweetPartitionedStream() partition 2 health = %+v", health[1])
+	}
+	wantRequests := map[string][]string{
+		"1": {"", "5"},
+		"2": {""},
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("Client.TweetPartitionedStream() requests = %v, want %v", requests, wantRequests)
+	}
+
+	if _, err := c.TweetPartitionedStream(context.Background(), TweetPartitionStreamSample10, TweetPartitionedStreamOpts{Partitions: []int{3}}); err == nil {
+		t.Errorf("Client.TweetPartitionedStream() want partition error")
+	}
+}
@@ -0,0 +1,197 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const tweetRetweetsTestBody = `{
+	"data": [
+		{
+			"id": "1460323737035677698",
+			"text": "RT @TwitterDev: Introducing a new era for the Twitter Developer Platform!",
+			"author_id": "2244994945",
+			"referenced_tweets": [{"type": "retweeted", "id": "1460323700000000000"}]
+		}
+	],
+	"includes": {
+		"users": [
+			{"id": "2244994945", "name": "Twitter Dev", "username": "TwitterDev"}
+		]
+	},
+	"meta": {
+		"result_count": 1,
+		"next_token": "7140dibdnow9c7btw3z2vwioavpvutgzrzm9icis4ndix"
+	}
+}`
+
+func tweetRetweetsTestClient(method string, ep string) *http.Client {
+	return mockHTTPClient(func(req *http.Request) *http.Response {
+		if req.Method != method {
+			log.Panicf("the method is not correct %s %s", req.Method, method)
+		}
+		if strings.Contains(req.URL.String(), ep) == false {
+			log.Panicf("the url is not correct %s %s", req.URL.String(), ep)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(tweetRetweetsTestBody)),
+			Header: func() http.Header {
+				h := http.Header{}
+				h.Add(rateLimit, "15")
+				h.Add(rateRemaining, "12")
+				h.Add(rateReset, "1644461060")
+				return h
+			}(),
+		}
+	})
+}
+
+func tweetRetweetsTestRaw() *TweetRaw {
+	return &TweetRaw{
+		Tweets: []*TweetObj{
+			{
+				ID:       "1460323737035677698",
+				Text:     "RT @TwitterDev: Introducing a new era for the Twitter Developer Platform!",
+				AuthorID: "2244994945",
+				ReferencedTweets: []*TweetReferencedTweetObj{
+					{
+						Type: "retweeted",
+						ID:   "1460323700000000000",
+					},
+				},
+			},
+		},
+		Includes: &TweetRawIncludes{
+			Users: []*UserObj{
+				{
+					ID:       "2244994945",
+					Name:     "Twitter Dev",
+					UserName: "TwitterDev",
+				},
+			},
+		},
+	}
+}
+
+func TestClient_TweetRetweetsLookup(t *testing.T) {
+	type args struct {
+		tweetID string
+		opts    TweetRetweetsLookupOpts
+	}
+	tests := []struct {
+		name        string
+		client      *http.Client
+		authContext AuthContext
+		args        args
+		want        *TweetRetweetsLookupResponse
+		wantErr     bool
+	}{
+		{
+			name:   "success",
+			client: tweetRetweetsTestClient(http.MethodGet, tweetRetweetsLookupEndpoint.urlID("", "1460323700000000000")),
+			args: args{
+				tweetID: "1460323700000000000",
+				opts: TweetRetweetsLookupOpts{
+					TweetSelection: TweetSelection{
+						Expansions: []Expansion{ExpansionAuthorID},
+					},
+					MaxResults: 10,
+				},
+			},
+			want: &TweetRetweetsLookupResponse{
+				Raw: tweetRetweetsTestRaw(),
+				Meta: &TweetRetweetsMeta{
+					ResultCount: 1,
+					NextToken:   "7140dibdnow9c7btw3z2vwioavpvutgzrzm9icis4ndix",
+				},
+				RateLimit: &RateLimit{
+					Limit:     15,
+					Remaining: 12,
+					Reset:     Epoch(1644461060),
+				},
+			},
+		},
+		{
+			name:   "max results",
+			client: tweetRetweetsTestClient(http.MethodGet, ""),
+			args: args{
+				tweetID: "1460323700000000000",
+				opts: TweetRetweetsLookupOpts{
+					MaxResults: 101,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "no id",
+			client:  tweetRetweetsTestClient(http.MethodGet, ""),
+			wantErr: true,
+		},
+		{
+			name: "metrics with app only",
+			client: mockHTTPClient(func(req *http.Request) *http.Response {
+				log.Panicf("the request should not be made %s", req.URL.String())
+				return nil
+			}),
+			authContext: AuthContextApp,
+			args: args{
+				tweetID: "1460323700000000000",
+				opts: TweetRetweetsLookupOpts{
+					TweetSelection: TweetSelection{
+						TweetFields: []TweetField{TweetFieldNonPublicMetrics},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer:  &mockAuth{},
+				Client:      tt.client,
+				Host:        "https://www.test.com",
+				AuthContext: tt.authContext,
+			}
+			got, err := c.TweetRetweetsLookup(context.Background(), tt.args.tweetID, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.TweetRetweetsLookup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.TweetRetweetsLookup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_UserRepostsOfMe(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Client:     tweetRetweetsTestClient(http.MethodGet, string(userRepostsOfMeEndpoint)),
+		Host:       "https://www.test.com",
+	}
+	got, err := c.UserRepostsOfMe(context.Background(), UserRepostsOfMeOpts{
+		TweetSelection: TweetSelection{
+			Expansions: []Expansion{ExpansionAuthorID},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Client.UserRepostsOfMe() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.Raw, tweetRetweetsTestRaw()) {
+		t.Errorf("Client.UserRepostsOfMe() = %v, want %v", got.Raw, tweetRetweetsTestRaw())
+	}
+
+	dictionaries := got.Raw.TweetDictionaries()
+	dictionary, has := dictionaries["1460323737035677698"]
+	if !has || dictionary.Author == nil || dictionary.Author.UserName != "TwitterDev" {
+		t.Errorf("Client.UserRepostsOfMe() dictionaries = %v", dictionaries)
+	}
+}
@@ -266,8 +266,10 @@ func TestClient_TweetRecentSearch(t *testing.T) {
 			args: args{
 				query: "phython",
 				opts: TweetRecentSearchOpts{
-					MaxResults:  10,
-					TweetFields: []TweetField{TweetFieldCreatedAt, TweetFieldLanguage, TweetFieldConversationID},
+					MaxResults: 10,
+					TweetSelection: TweetSelection{
+						TweetFields: []TweetField{TweetFieldCreatedAt, TweetFieldLanguage, TweetFieldConversationID},
+					},
 				},
 			},
 			want: &TweetRecentSearchResponse{
@@ -668,8 +670,10 @@ func TestClient_TweetSearch(t *testing.T) {
 			args: args{
 				query: "python",
 				opts: TweetSearchOpts{
-					MaxResults:  10,
-					TweetFields: []TweetField{TweetFieldCreatedAt, TweetFieldLanguage, TweetFieldConversationID},
+					MaxResults: 10,
+					TweetSelection: TweetSelection{
+						TweetFields: []TweetField{TweetFieldCreatedAt, TweetFieldLanguage, TweetFieldConversationID},
+					},
 				},
 			},
 			want: &TweetSearchResponse{
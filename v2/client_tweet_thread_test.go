@@ -0,0 +1,242 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitTweetThread(t *testing.T) {
+	sentence := strings.Repeat("word ", 19) + "end."
+	tests := []struct {
+		name string
+		text string
+		opts TweetThreadOpts
+		want []string
+	}{
+		{
+			name: "one tweet",
+			text: "  Hello World  ",
+			want: []string{"Hello World"},
+		},
+		{
+			name: "sentences",
+			text: strings.Join([]string{sentence, sentence, sentence}, " "),
+			want: []string{sentence + " " + sentence, sentence},
+		},
+		{
+			name: "numbering",
+			text: strings.Join([]string{sentence, sentence, sentence}, " "),
+			opts: TweetThreadOpts{
+				Numbering: true,
+			},
+			want: []string{sentence + " " + sentence, sentence},
+		},
+		{
+			name: "words",
+			text: strings.Repeat("abcd ", 60),
+			want: []string{strings.TrimSpace(strings.Repeat("abcd ", 56)), strings.TrimSpace(strings.Repeat("abcd ", 4))},
+		},
+		{
+			name: "long word",
+			text: strings.Repeat("a", 300),
+			want: []string{strings.Repeat("a", 280), strings.Repeat("a", 20)},
+		},
+		{
+			name: "urls",
+			text: strings.Repeat("https://go.dev/doc/effective_go ", 12),
+			want: []string{strings.TrimSpace(strings.Repeat("https://go.dev/doc/effective_go ", 11)), "https://go.dev/doc/effective_go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitTweetThread(tt.text, tt.opts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitTweetThread() = %v, want %v", got, tt.want)
+			}
+			for i, segment := range got {
+				if tt.opts.Numbering {
+					segment += tweetThreadNumber(i, len(got))
+				}
+				if length := TweetWeightedLength(segment); length > createTweetTextMaxLength {
+					t.Errorf("SplitTweetThread() segment %d length %d", i, length)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_CreateTweetThread(t *testing.T) {
+	type created struct {
+		text    string
+		replyID string
+	}
+	posted := []created{}
+	deleted := []string{}
+	failOn := 2
+
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			switch req.Method {
+			case http.MethodPost:
+				if len(posted) == failOn {
+					failOn = -1
+					return &http.Response{
+						StatusCode: http.StatusServiceUnavailable,
+						Body:       io.NopCloser(strings.NewReader(`{"title":"Service Unavailable","detail":"try again"}`)),
+						Header:     http.Header{},
+					}
+				}
+				tweet := CreateTweetRequest{}
+				if err := json.NewDecoder(req.Body).Decode(&tweet); err != nil {
+					log.Panicf("the request body is not correct %v", err)
+				}
+				replyID := ""
+				if tweet.Reply != nil {
+					replyID = tweet.Reply.InReplyToTweetID
+				}
+				posted = append(posted, created{text: tweet.Text, replyID: replyID})
+				body := fmt.Sprintf(`{"data":{"id":"%d","text":%q}}`, len(posted), tweet.Text)
+				return &http.Response{
+					StatusCode: http.StatusCreated,
+					Body:       io.NopCloser(strings.NewReader(body)),
+					Header:     http.Header{},
+				}
+			case http.MethodDelete:
+				deleted = append(deleted, req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:])
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"data":{"deleted":true}}`)),
+					Header:     http.Header{},
+				}
+			default:
+				log.Panicf("the method is not correct %s", req.Method)
+				return nil
+			}
+		}),
+	}
+
+	thread := &TweetThread{
+		Segments: []TweetThreadSegment{
+			{Text: "first"},
+			{Text: "second", Media: &CreateTweetMedia{IDs: []string{"m1"}}},
+			{Text: "third"},
+		},
+		Opts: TweetThreadOpts{
+			Numbering:        true,
+			InReplyToTweetID: "100",
+		},
+	}
+
+	_, err := c.CreateTweetThread(context.Background(), thread)
+	threadErr := &TweetThreadError{}
+	if !errors.As(err, &threadErr) {
+		t.Fatalf("Client.CreateTweetThread() error = %v, want TweetThreadError", err)
+	}
+	if threadErr.Index != 2 || len(threadErr.Posted) != 2 {
+		t.Fatalf("Client.CreateTweetThread() error index %d posted %d", threadErr.Index, len(threadErr.Posted))
+	}
+	errResp := &ErrorResponse{}
+	if !errors.As(err, &errResp) || errResp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Client.CreateTweetThread() error = %v, want ErrorResponse", err)
+	}
+
+	// resume the thread
+	resp, err := c.CreateTweetThread(context.Background(), thread)
+	if err != nil {
+		t.Fatalf("Client.CreateTweetThread() resume error = %v", err)
+	}
+	want := []created{
+		{text: "first 1/3", replyID: "100"},
+		{text: "second 2/3", replyID: "1"},
+		{text: "third 3/3", replyID: "2"},
+	}
+	if !reflect.DeepEqual(posted, want) {
+		t.Errorf("Client.CreateTweetThread() posted = %v, want %v", posted, want)
+	}
+	if len(resp.Tweets) != 3 || !thread.Done() {
+		t.Errorf("Client.CreateTweetThread() tweets = %d, done %v", len(resp.Tweets), thread.Done())
+	}
+
+	rollback, err := c.RollbackTweetThread(context.Background(), thread)
+	if err != nil {
+		t.Fatalf("Client.RollbackTweetThread() error = %v", err)
+	}
+	if wantDeleted := []string{"3", "2", "1"}; !reflect.DeepEqual(deleted, wantDeleted) || !reflect.DeepEqual(rollback.DeletedIDs, wantDeleted) {
+		t.Errorf("Client.RollbackTweetThread() deleted = %v, want %v", deleted, wantDeleted)
+	}
+	if len(thread.Posted) != 0 {
+		t.Errorf("Client.RollbackTweetThread() posted = %d", len(thread.Posted))
+	}
+}
+
+func TestClient_CreateTweetThread_invalid(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			log.Panicf("the request should not be made %s", req.URL.String())
+			return nil
+		}),
+	}
+	thread := &TweetThread{
+		Segments: []TweetThreadSegment{
+			{Text: "first"},
+			{Text: strings.Repeat("a", 281)},
+		},
+	}
+	if _, err := c.CreateTweetThread(context.Background(), thread); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.CreateTweetThread() error = %v, want %v", err, ErrParameter)
+	}
+}
+
+func TestClient_CreateTweetThread_noTweet(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost {
+				log.Panicf("the method is not correct %s", req.Method)
+			}
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	thread := &TweetThread{
+		Segments: []TweetThreadSegment{
+			{Text: "first"},
+			{Text: "second"},
+		},
+	}
+
+	_, err := c.CreateTweetThread(context.Background(), thread)
+	threadErr := &TweetThreadError{}
+	if !errors.As(err, &threadErr) {
+		t.Fatalf("Client.CreateTweetThread() error = %v, want TweetThreadError", err)
+	}
+	if threadErr.Index != 0 || len(threadErr.Posted) != 0 || len(thread.Posted) != 0 {
+		t.Errorf("Client.CreateTweetThread() error index %d posted %d thread posted %d", threadErr.Index, len(threadErr.Posted), len(thread.Posted))
+	}
+
+	// a thread that was saved with an empty tweet can still be rolled back
+	thread.Posted = []*CreateTweetData{nil}
+	rollback, err := c.RollbackTweetThread(context.Background(), thread)
+	if err != nil {
+		t.Fatalf("Client.RollbackTweetThread() error = %v", err)
+	}
+	if len(rollback.DeletedIDs) != 0 || len(thread.Posted) != 0 {
+		t.Errorf("Client.RollbackTweetThread() deleted = %v, posted %d", rollback.DeletedIDs, len(thread.Posted))
+	}
+}
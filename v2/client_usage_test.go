@@ -0,0 +1,247 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_UsageTweets(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		opts UsageTweetsOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *UsageTweetsResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), string(usageTweetsEndpoint)) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), usageTweetsEndpoint)
+					}
+					if req.URL.Query().Get("days") != "2" {
+						log.Panicf("the days is not correct %s", req.URL.String())
+					}
+					body := `{
+						"data": {
+							"cap_reset_day": 19,
+							"project_id": "1234",
+							"project_cap": "10000",
+							"project_usage": "9000",
+							"daily_project_usage": {
+								"project_id": "1234",
+								"usage": [
+									{"date": "2023-05-01T00:00:00.000Z", "usage": "4000"},
+									{"date": "2023-05-02T00:00:00.000Z", "usage": "5000"}
+								]
+							},
+							"daily_client_app_usage": [
+								{
+									"client_app_id": "5678",
+									"usage": [
+										{"date": "2023-05-01T00:00:00.000Z", "usage": 4000}
+									],
+									"usage_result_count": 1
+								}
+							]
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header: func() http.Header {
+							h := http.Header{}
+							h.Add(rateLimit, "50")
+							h.Add(rateRemaining, "49")
+							h.Add(rateReset, "1644461060")
+							return h
+						}(),
+					}
+				}),
+			},
+			args: args{
+				opts: UsageTweetsOpts{
+					Days:        2,
+					UsageFields: []UsageField{UsageFieldDailyProjectUsage, UsageFieldDailyClientAppUsage},
+				},
+			},
+			want: &UsageTweetsResponse{
+				Raw: &UsageTweetsRaw{
+					Usage: &UsageTweetsObj{
+						CapResetDay:  19,
+						ProjectID:    "1234",
+						ProjectCap:   10000,
+						ProjectUsage: 9000,
+						DailyProjectUsage: &UsageDailyProjectObj{
+							ProjectID: "1234",
+							Usage: []*UsageDayObj{
+								{Date: "2023-05-01T00:00:00.000Z", Usage: 4000},
+								{Date: "2023-05-02T00:00:00.000Z", Usage: 5000},
+							},
+						},
+						DailyClientAppUsage: []*UsageDailyClientAppObj{
+							{
+								ClientAppID: "5678",
+								Usage: []*UsageDayObj{
+									{Date: "2023-05-01T00:00:00.000Z", Usage: 4000},
+								},
+								UsageResultCount: 1,
+							},
+						},
+					},
+				},
+				RateLimit: &RateLimit{
+					Limit:     50,
+					Remaining: 49,
+					Reset:     Epoch(1644461060),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "days out of range",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be made %s", req.URL.String())
+					return nil
+				}),
+			},
+			args: args{
+				opts: UsageTweetsOpts{
+					Days: 91,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "bad request",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					body := `{
+						"title": "Unauthorized",
+						"type": "about:blank",
+						"status": 401,
+						"detail": "Unauthorized"
+					}`
+					return &http.Response{
+						StatusCode: http.StatusUnauthorized,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     http.Header{},
+					}
+				}),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.UsageTweets(context.Background(), tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.UsageTweets() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.UsageTweets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_UsageCounter(t *testing.T) {
+	alerts := []int64{}
+	counter := NewTweetUsageCounter(TweetUsageCounterOpts{
+		Cap:            10,
+		AlertThreshold: 0.5,
+		OnAlert: func(count, tweetCap int64) {
+			alerts = append(alerts, count)
+		},
+	})
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			body := `{
+				"data": [
+					{"id": "1", "text": "one"},
+					{"id": "2", "text": "two"}
+				],
+				"meta": {"result_count": 2}
+			}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}
+		}),
+		UsageCounter: counter,
+	}
+
+	if _, err := c.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+	if _, err := c.UserTweetTimeline(context.Background(), "2244994945", UserTweetTimelineOpts{}); err != nil {
+		t.Fatalf("Client.UserTweetTimeline() error = %v", err)
+	}
+	if len(alerts) != 0 {
+		t.Errorf("TweetUsageCounter alerts = %v, want none", alerts)
+	}
+
+	stream := startTweetStream(io.NopCloser(strings.NewReader(`{"data":{"id":"3","text":"three"}}`+"\r\n")), nil, counter)
+	<-stream.Tweets()
+	stream.Close()
+
+	if counter.Count() != 5 {
+		t.Errorf("TweetUsageCounter.Count() = %v, want 5", counter.Count())
+	}
+	want := map[TweetUsageSource]int64{
+		TweetUsageSourceSearch:   2,
+		TweetUsageSourceTimeline: 2,
+		TweetUsageSourceStream:   1,
+	}
+	if got := counter.BySource(); !reflect.DeepEqual(got, want) {
+		t.Errorf("TweetUsageCounter.BySource() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(alerts, []int64{5}) {
+		t.Errorf("TweetUsageCounter alerts = %v, want [5]", alerts)
+	}
+
+	comparison := counter.Compare(&UsageTweetsObj{ProjectUsage: 7})
+	if comparison.Difference != 2 {
+		t.Errorf("TweetUsageCounter.Compare() = %+v", comparison)
+	}
+
+	counter.Reset()
+	if counter.Count() != 0 || counter.Remaining() != 10 {
+		t.Errorf("TweetUsageCounter.Reset() count = %v remaining = %v", counter.Count(), counter.Remaining())
+	}
+}
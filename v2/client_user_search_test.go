@@ -0,0 +1,231 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_UserSearch(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		query string
+		opts  UserSearchOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *UserSearchResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), string(userSearchEndpoint)) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), userSearchEndpoint)
+					}
+					if req.URL.Query().Get("query") != "developers" || req.URL.Query().Get("next_token") != "token" {
+						log.Panicf("the query is not correct %s", req.URL.String())
+					}
+					body := `{
+						"data": [
+							{
+								"id": "2244994945",
+								"name": "Twitter Dev",
+								"username": "TwitterDev",
+								"description": "The voice of the X Dev team"
+							}
+						],
+						"meta": {
+							"result_count": 1,
+							"next_token": "next"
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header: func() http.Header {
+							h := http.Header{}
+							h.Add(rateLimit, "300")
+							h.Add(rateRemaining, "299")
+							h.Add(rateReset, "1644461060")
+							return h
+						}(),
+					}
+				}),
+			},
+			args: args{
+				query: "developers",
+				opts: UserSearchOpts{
+					UserFields: []UserField{UserFieldDescription},
+					NextToken:  "token",
+				},
+			},
+			want: &UserSearchResponse{
+				Raw: &UserRaw{
+					Users: []*UserObj{
+						{
+							ID:          "2244994945",
+							Name:        "Twitter Dev",
+							UserName:    "TwitterDev",
+							Description: "The voice of the X Dev team",
+						},
+					},
+				},
+				Meta: &UserSearchMeta{
+					ResultCount: 1,
+					NextToken:   "next",
+				},
+				RateLimit: &RateLimit{
+					Limit:     300,
+					Remaining: 299,
+					Reset:     Epoch(1644461060),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no query",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be made %s", req.URL.String())
+					return nil
+				}),
+			},
+			args:    args{},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.UserSearch(context.Background(), tt.args.query, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.UserSearch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.UserSearch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_TrendsByWOEID(t *testing.T) {
+	type args struct {
+		woeid int
+		opts  TrendsByWOEIDOpts
+	}
+	tests := []struct {
+		name    string
+		client  *http.Client
+		args    args
+		want    *TrendsByWOEIDResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			client: mockHTTPClient(func(req *http.Request) *http.Response {
+				if req.Method != http.MethodGet {
+					log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+				}
+				if strings.Contains(req.URL.String(), trendsByWOEIDEndpoint.urlID("", "1")) == false {
+					log.Panicf("the url is not correct %s %s", req.URL.String(), trendsByWOEIDEndpoint)
+				}
+				if req.URL.Query().Get("max_trends") != "2" {
+					log.Panicf("the max trends is not correct %s", req.URL.String())
+				}
+				body := `{
+					"data": [
+						{"trend_name": "#golang", "tweet_count": 12345},
+						{"trend_name": "gophers", "tweet_count": 678}
+					]
+				}`
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(body)),
+					Header: func() http.Header {
+						h := http.Header{}
+						h.Add(rateLimit, "75")
+						h.Add(rateRemaining, "74")
+						h.Add(rateReset, "1644461060")
+						return h
+					}(),
+				}
+			}),
+			args: args{
+				woeid: 1,
+				opts: TrendsByWOEIDOpts{
+					MaxTrends:   2,
+					TrendFields: []TrendField{TrendFieldTrendName, TrendFieldTweetCount},
+				},
+			},
+			want: &TrendsByWOEIDResponse{
+				Raw: &TrendsRaw{
+					Trends: []*TrendObj{
+						{TrendName: "#golang", TweetCount: 12345},
+						{TrendName: "gophers", TweetCount: 678},
+					},
+				},
+				RateLimit: &RateLimit{
+					Limit:     75,
+					Remaining: 74,
+					Reset:     Epoch(1644461060),
+				},
+			},
+		},
+		{
+			name: "max trends",
+			client: mockHTTPClient(func(req *http.Request) *http.Response {
+				log.Panicf("the request should not be made %s", req.URL.String())
+				return nil
+			}),
+			args: args{
+				woeid: 1,
+				opts: TrendsByWOEIDOpts{
+					MaxTrends: 51,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: &mockAuth{},
+				Client:     tt.client,
+				Host:       "https://www.test.com",
+			}
+			got, err := c.TrendsByWOEID(context.Background(), tt.args.woeid, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.TrendsByWOEID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.TrendsByWOEID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
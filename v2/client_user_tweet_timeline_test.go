@@ -192,10 +192,12 @@ func TestClient_UserTweetTimeline(t *testing.T) {
 			args: args{
 				userID: "2244994945",
 				opts: UserTweetTimelineOpts{
-					TweetFields: []TweetField{TweetFieldCreatedAt, TweetFieldAuthorID, TweetFieldConversationID, TweetFieldPublicMetrics, TweetFieldContextAnnotations},
-					UserFields:  []UserField{UserFieldUserName},
-					Expansions:  []Expansion{ExpansionAuthorID},
-					MaxResults:  10,
+					TweetSelection: TweetSelection{
+						Expansions:  []Expansion{ExpansionAuthorID},
+						TweetFields: []TweetField{TweetFieldCreatedAt, TweetFieldAuthorID, TweetFieldConversationID, TweetFieldPublicMetrics, TweetFieldContextAnnotations},
+						UserFields:  []UserField{UserFieldUserName},
+					},
+					MaxResults: 10,
 				},
 			},
 			want: &UserTweetTimelineResponse{
@@ -443,10 +445,12 @@ func TestClient_UserTweetReverseChronologicalTimeline(t *testing.T) {
 			args: args{
 				userID: "2244994945",
 				opts: UserTweetReverseChronologicalTimelineOpts{
-					Expansions:  []Expansion{ExpansionAuthorID},
-					TweetFields: []TweetField{TweetFieldCreatedAt},
-					UserFields:  []UserField{UserFieldCreatedAt, UserFieldName},
-					MaxResults:  5,
+					TweetSelection: TweetSelection{
+						Expansions:  []Expansion{ExpansionAuthorID},
+						TweetFields: []TweetField{TweetFieldCreatedAt},
+						UserFields:  []UserField{UserFieldCreatedAt, UserFieldName},
+					},
+					MaxResults: 5,
 				},
 			},
 			want: &UserTweetReverseChronologicalTimelineResponse{
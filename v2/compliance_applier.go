@@ -0,0 +1,199 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ComplianceAuditOperation is the operation that the applier has taken
+type ComplianceAuditOperation string
+
+const (
+	// ComplianceAuditDeleteTweet is a tweet that has been deleted
+	ComplianceAuditDeleteTweet ComplianceAuditOperation = "delete_tweet"
+	// ComplianceAuditDeleteUser is an user that has been deleted
+	ComplianceAuditDeleteUser ComplianceAuditOperation = "delete_user"
+	// ComplianceAuditDeleteLike is a like that has been deleted
+	ComplianceAuditDeleteLike ComplianceAuditOperation = "delete_like"
+	// ComplianceAuditRedactTweetGeo is a tweet that has had the geo removed
+	ComplianceAuditRedactTweetGeo ComplianceAuditOperation = "redact_tweet_geo"
+	// ComplianceAuditRedactUserGeo is an user's tweets that have had the geo removed
+	ComplianceAuditRedactUserGeo ComplianceAuditOperation = "redact_user_geo"
+	// ComplianceAuditMarkProtected is an user that has been marked as protected
+	ComplianceAuditMarkProtected ComplianceAuditOperation = "mark_protected"
+	// ComplianceAuditMarkUnprotected is an user that has been marked as not protected
+	ComplianceAuditMarkUnprotected ComplianceAuditOperation = "mark_unprotected"
+	// ComplianceAuditSkip is a compliance result or event that does not change the store
+	ComplianceAuditSkip ComplianceAuditOperation = "skip"
+)
+
+// ComplianceAuditSource is where the compliance action came from
+type ComplianceAuditSource string
+
+const (
+	// ComplianceAuditSourceBatch is a compliance batch job result
+	ComplianceAuditSourceBatch ComplianceAuditSource = "batch"
+	// ComplianceAuditSourceStream is a compliance stream event
+	ComplianceAuditSourceStream ComplianceAuditSource = "stream"
+)
+
+// ComplianceAuditEntry is an entry in the audit log for every action that is taken
+type ComplianceAuditEntry struct {
+	Time        time.Time                `json:"time"`
+	Source      ComplianceAuditSource    `json:"source"`
+	Operation   ComplianceAuditOperation `json:"operation"`
+	ID          string                   `json:"id,omitempty"`
+	UserID      string                   `json:"user_id,omitempty"`
+	UpToTweetID string                   `json:"up_to_tweet_id,omitempty"`
+	Action      string                   `json:"action"`
+	Reason      string                   `json:"reason,omitempty"`
+	Error       string                   `json:"error,omitempty"`
+}
+
+// ComplianceApplier applies the compliance batch job results and the compliance stream events to a store.  Each
+// action is written to the audit log as a JSON line.
+type ComplianceApplier struct {
+	store ComplianceStore
+	audit io.Writer
+	mutex sync.Mutex
+	now   func() time.Time
+}
+
+// NewComplianceApplier creates an applier for the store, the audit log is optional
+func NewComplianceApplier(store ComplianceStore, audit io.Writer) *ComplianceApplier {
+	return &ComplianceApplier{
+		store: store,
+		audit: audit,
+		now:   time.Now,
+	}
+}
+
+// BatchResultHandler returns a handler that will apply the results of the job type, this can be used with the
+// compliance batch workflow or the job download stream.
+func (a *ComplianceApplier) BatchResultHandler(jobType ComplianceBatchJobType) ComplianceBatchResultHandler {
+	return func(result *ComplianceBatchJobResult) error {
+		return a.ApplyBatchResult(jobType, result)
+	}
+}
+
+// ApplyBatchResult will apply the compliance batch job result.  The job type determines if the result is for
+// a tweet or an user.
+func (a *ComplianceApplier) ApplyBatchResult(jobType ComplianceBatchJobType, result *ComplianceBatchJobResult) error {
+	entry := ComplianceAuditEntry{
+		Source: ComplianceAuditSourceBatch,
+		ID:     result.ID,
+		Action: result.Action,
+		Reason: result.Reason,
+	}
+
+	switch {
+	case jobType == ComplianceBatchJobTypeTweets && result.ActionType() == ComplianceBatchJobActionDelete:
+		entry.Operation = ComplianceAuditDeleteTweet
+		return a.apply(entry, func() error { return a.store.DeleteTweet(result.ID) })
+	case jobType == ComplianceBatchJobTypeTweets && result.ActionType() == ComplianceBatchJobActionScrubGeo:
+		entry.Operation = ComplianceAuditRedactTweetGeo
+		return a.apply(entry, func() error { return a.store.RedactTweetGeo(result.ID) })
+	case jobType == ComplianceBatchJobTypeUsers && result.ReasonType() == ComplianceBatchJobReasonProtected:
+		entry.Operation = ComplianceAuditMarkProtected
+		entry.UserID = result.ID
+		return a.apply(entry, func() error { return a.store.MarkProtected(result.ID, true) })
+	case jobType == ComplianceBatchJobTypeUsers && result.ActionType() == ComplianceBatchJobActionDelete:
+		entry.Operation = ComplianceAuditDeleteUser
+		entry.UserID = result.ID
+		return a.apply(entry, func() error { return a.store.DeleteUser(result.ID) })
+	case jobType == ComplianceBatchJobTypeUsers && result.ActionType() == ComplianceBatchJobActionScrubGeo:
+		entry.Operation = ComplianceAuditRedactUserGeo
+		entry.UserID = result.ID
+		return a.apply(entry, func() error { return a.store.RedactUserGeo(result.ID, "") })
+	default:
+		entry.Operation = ComplianceAuditSkip
+		return a.apply(entry, nil)
+	}
+}
+
+// ApplyStreamEvent will apply the compliance stream event.  Events that do not remove or redact data, like
+// undrop or user_unsuspend, are recorded in the audit log as skipped.
+func (a *ComplianceApplier) ApplyStreamEvent(event *ComplianceStreamEvent) error {
+	entry := ComplianceAuditEntry{
+		Source:      ComplianceAuditSourceStream,
+		Action:      string(event.Type),
+		UpToTweetID: event.UpToTweetID,
+	}
+	if event.Tweet != nil {
+		entry.ID = event.Tweet.ID
+		entry.UserID = event.Tweet.AuthorID
+	}
+	if event.User != nil {
+		entry.UserID = event.User.ID
+	}
+	if event.Favorite != nil {
+		entry.ID = event.Favorite.ID
+		entry.UserID = event.Favorite.UserID
+	}
+
+	switch {
+	case event.Type == ComplianceStreamEventDelete && event.Favorite != nil:
+		entry.Operation = ComplianceAuditDeleteLike
+		return a.apply(entry, func() error { return a.store.DeleteLike(event.Favorite.UserID, event.Favorite.ID) })
+	case (event.Type == ComplianceStreamEventDelete || event.Type == ComplianceStreamEventDrop) && event.Tweet != nil:
+		entry.Operation = ComplianceAuditDeleteTweet
+		return a.apply(entry, func() error { return a.store.DeleteTweet(event.Tweet.ID) })
+	case event.Type == ComplianceStreamEventScrubGeo && event.User != nil:
+		entry.Operation = ComplianceAuditRedactUserGeo
+		return a.apply(entry, func() error { return a.store.RedactUserGeo(event.User.ID, event.UpToTweetID) })
+	case (event.Type == ComplianceStreamEventUserDelete || event.Type == ComplianceStreamEventUserSuspend) && event.User != nil:
+		entry.Operation = ComplianceAuditDeleteUser
+		return a.apply(entry, func() error { return a.store.DeleteUser(event.User.ID) })
+	case event.Type == ComplianceStreamEventUserProtect && event.User != nil:
+		entry.Operation = ComplianceAuditMarkProtected
+		return a.apply(entry, func() error { return a.store.MarkProtected(event.User.ID, true) })
+	case event.Type == ComplianceStreamEventUserUnprotect && event.User != nil:
+		entry.Operation = ComplianceAuditMarkUnprotected
+		return a.apply(entry, func() error { return a.store.MarkProtected(event.User.ID, false) })
+	default:
+		entry.Operation = ComplianceAuditSkip
+		return a.apply(entry, nil)
+	}
+}
+
+func (a *ComplianceApplier) apply(entry ComplianceAuditEntry, op func() error) error {
+	var opErr error
+	if op != nil {
+		opErr = op()
+	}
+	if opErr != nil {
+		entry.Error = opErr.Error()
+	}
+	entry.Time = a.now()
+
+	if err := a.record(entry); err != nil {
+		return err
+	}
+	if opErr != nil {
+		id := entry.ID
+		if len(id) == 0 {
+			id = entry.UserID
+		}
+		return fmt.Errorf("compliance applier %s %s: %w", entry.Operation, id, opErr)
+	}
+	return nil
+}
+
+func (a *ComplianceApplier) record(entry ComplianceAuditEntry) error {
+	if a.audit == nil {
+		return nil
+	}
+	enc, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("compliance applier audit encode: %w", err)
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if _, err := a.audit.Write(append(enc, '\n')); err != nil {
+		return fmt.Errorf("compliance applier audit write: %w", err)
+	}
+	return nil
+}
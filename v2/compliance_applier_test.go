@@ -0,0 +1,213 @@
+package twitter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func complianceApplierTestStore(t *testing.T, store interface {
+	PutTweet(*TweetObj) error
+	PutUser(*UserObj) error
+	PutLike(string, string) error
+}) {
+	errs := []error{
+		store.PutUser(&UserObj{ID: "10", UserName: "gopher"}),
+		store.PutUser(&UserObj{ID: "11", UserName: "protected"}),
+		store.PutTweet(&TweetObj{ID: "100", AuthorID: "10", Geo: &TweetGeoObj{PlaceID: "place"}}),
+		store.PutTweet(&TweetObj{ID: "101", AuthorID: "10", Geo: &TweetGeoObj{PlaceID: "place"}}),
+		store.PutTweet(&TweetObj{ID: "99", AuthorID: "11", Geo: &TweetGeoObj{PlaceID: "place_99"}}),
+		store.PutLike("11", "100"),
+	}
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("ComplianceStore put error = %v", err)
+		}
+	}
+}
+
+func TestComplianceApplier_ApplyBatchResult(t *testing.T) {
+	store := NewComplianceMemoryStore()
+	complianceApplierTestStore(t, store)
+	audit := &bytes.Buffer{}
+	applier := NewComplianceApplier(store, audit)
+	applier.now = func() time.Time { return time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC) }
+
+	tweets := applier.BatchResultHandler(ComplianceBatchJobTypeTweets)
+	if err := tweets(&ComplianceBatchJobResult{ID: "100", Action: "delete", Reason: "deleted"}); err != nil {
+		t.Fatalf("ComplianceApplier.ApplyBatchResult() error = %v", err)
+	}
+	if err := tweets(&ComplianceBatchJobResult{ID: "99", Action: "scrub_geo", Reason: "scrub_geo"}); err != nil {
+		t.Fatalf("ComplianceApplier.ApplyBatchResult() error = %v", err)
+	}
+	users := applier.BatchResultHandler(ComplianceBatchJobTypeUsers)
+	if err := users(&ComplianceBatchJobResult{ID: "11", Action: "delete", Reason: "protected"}); err != nil {
+		t.Fatalf("ComplianceApplier.ApplyBatchResult() error = %v", err)
+	}
+	if err := users(&ComplianceBatchJobResult{ID: "12", Action: "unknown"}); err != nil {
+		t.Fatalf("ComplianceApplier.ApplyBatchResult() error = %v", err)
+	}
+
+	if store.Tweet("100") != nil {
+		t.Errorf("ComplianceApplier.ApplyBatchResult() tweet 100 was not deleted")
+	}
+	if tweet := store.Tweet("99"); tweet == nil || tweet.Geo != nil {
+		t.Errorf("ComplianceApplier.ApplyBatchResult() tweet 99 geo was not redacted %v", tweet)
+	}
+	if user := store.User("11"); user == nil || !user.Protected {
+		t.Errorf("ComplianceApplier.ApplyBatchResult() user 11 was not protected %v", user)
+	}
+
+	operations := []ComplianceAuditOperation{}
+	scanner := bufio.NewScanner(audit)
+	for scanner.Scan() {
+		entry := ComplianceAuditEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("ComplianceApplier audit decode error = %v", err)
+		}
+		if entry.Source != ComplianceAuditSourceBatch || entry.Time.IsZero() {
+			t.Errorf("ComplianceApplier audit entry = %+v", entry)
+		}
+		operations = append(operations, entry.Operation)
+	}
+	want := []ComplianceAuditOperation{
+		ComplianceAuditDeleteTweet,
+		ComplianceAuditRedactTweetGeo,
+		ComplianceAuditMarkProtected,
+		ComplianceAuditSkip,
+	}
+	if !reflect.DeepEqual(operations, want) {
+		t.Errorf("ComplianceApplier audit = %v, want %v", operations, want)
+	}
+}
+
+func TestComplianceApplier_ApplyStreamEvent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dataset.jsonl")
+	store, err := OpenComplianceFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenComplianceFileStore() error = %v", err)
+	}
+	complianceApplierTestStore(t, store)
+
+	applier := NewComplianceApplier(store, nil)
+	events := []*ComplianceStreamEvent{
+		{
+			Type:        ComplianceStreamEventScrubGeo,
+			User:        &ComplianceStreamUserObj{ID: "10"},
+			UpToTweetID: "100",
+		},
+		{
+			Type:     ComplianceStreamEventDelete,
+			Favorite: &ComplianceStreamFavoriteObj{ID: "100", UserID: "11"},
+		},
+		{
+			Type: ComplianceStreamEventUserSuspend,
+			User: &ComplianceStreamUserObj{ID: "11"},
+		},
+		{
+			Type: ComplianceStreamEventUserProtect,
+			User: &ComplianceStreamUserObj{ID: "10"},
+		},
+		{
+			Type:                ComplianceStreamEventWithheld,
+			Tweet:               &ComplianceStreamTweetObj{ID: "101", AuthorID: "10"},
+			WithheldInCountries: []string{"DE"},
+		},
+	}
+	for _, event := range events {
+		if err := applier.ApplyStreamEvent(event); err != nil {
+			t.Fatalf("ComplianceApplier.ApplyStreamEvent() error = %v", err)
+		}
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("ComplianceFileStore.Close() error = %v", err)
+	}
+
+	dataset, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ComplianceFileStore read error = %v", err)
+	}
+	if !bytes.Contains(dataset, []byte(`"delete_user"`)) {
+		t.Errorf("ComplianceFileStore file does not contain the appended operations\n%s", dataset)
+	}
+
+	// the records are loaded from the file, compacting on the first open
+	for _, reopen := range []bool{false, true} {
+		store, err = OpenComplianceFileStore(path)
+		if err != nil {
+			t.Fatalf("OpenComplianceFileStore() error = %v", err)
+		}
+		if tweet := store.Tweet("100"); tweet == nil || tweet.Geo != nil {
+			t.Errorf("ComplianceFileStore tweet 100 geo was not redacted %v", tweet)
+		}
+		if tweet := store.Tweet("101"); tweet == nil || tweet.Geo == nil {
+			t.Errorf("ComplianceFileStore tweet 101 geo should not be redacted %v", tweet)
+		}
+		if store.Liked("11", "100") {
+			t.Errorf("ComplianceFileStore like was not deleted")
+		}
+		if store.User("11") != nil || store.Tweet("99") != nil {
+			t.Errorf("ComplianceFileStore user 11 was not deleted")
+		}
+		if user := store.User("10"); user == nil || !user.Protected {
+			t.Errorf("ComplianceFileStore user 10 was not protected %v", user)
+		}
+		if !reopen {
+			if err := store.Compact(); err != nil {
+				t.Fatalf("ComplianceFileStore.Compact() error = %v", err)
+			}
+		}
+		if err := store.Close(); err != nil {
+			t.Fatalf("ComplianceFileStore.Close() error = %v", err)
+		}
+	}
+
+	// the deleted and redacted data is removed from the file by compacting
+	dataset, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ComplianceFileStore read error = %v", err)
+	}
+	for _, removed := range []string{`"place_99"`, `"id":"99"`, `"username":"protected"`, `"user_id":"11"`, `"delete_`, `"redact_`} {
+		if bytes.Contains(dataset, []byte(removed)) {
+			t.Errorf("ComplianceFileStore file contains %s\n%s", removed, dataset)
+		}
+	}
+	if bytes.Count(dataset, []byte(`"place"`)) != 1 {
+		t.Errorf("ComplianceFileStore file geo was not redacted\n%s", dataset)
+	}
+}
+
+func TestComplianceFileStore_CompactError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dataset.jsonl")
+	store, err := OpenComplianceFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenComplianceFileStore() error = %v", err)
+	}
+	defer store.Close()
+	if err := store.PutTweet(&TweetObj{ID: "100", AuthorID: "10"}); err != nil {
+		t.Fatalf("ComplianceFileStore.PutTweet() error = %v", err)
+	}
+
+	// the rename fails when the path is replaced with a directory that is not empty
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("ComplianceFileStore remove error = %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(path, "blocked"), 0o700); err != nil {
+		t.Fatalf("ComplianceFileStore mkdir error = %v", err)
+	}
+	if err := store.Compact(); err == nil {
+		t.Fatalf("ComplianceFileStore.Compact() error = nil, want an error")
+	}
+
+	if err := store.DeleteTweet("100"); err != nil {
+		t.Errorf("ComplianceFileStore.DeleteTweet() after a failed compact error = %v", err)
+	}
+	if store.Tweet("100") != nil {
+		t.Errorf("ComplianceFileStore tweet 100 was not deleted")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 // ComplianceBatchJobStatus is the compliance batch job status
@@ -36,6 +37,32 @@ const (
 	ComplianceBatchJobTypeUsers ComplianceBatchJobType = "users"
 )
 
+// ComplianceBatchJobAction is the action to take on the tweet or user
+type ComplianceBatchJobAction string
+
+const (
+	// ComplianceBatchJobActionDelete is a tweet or user that must be deleted
+	ComplianceBatchJobActionDelete ComplianceBatchJobAction = "delete"
+	// ComplianceBatchJobActionScrubGeo is a tweet or user that must have the geo removed
+	ComplianceBatchJobActionScrubGeo ComplianceBatchJobAction = "scrub_geo"
+)
+
+// ComplianceBatchJobReason is the reason for the action
+type ComplianceBatchJobReason string
+
+const (
+	// ComplianceBatchJobReasonDeleted is a tweet or user that has been deleted
+	ComplianceBatchJobReasonDeleted ComplianceBatchJobReason = "deleted"
+	// ComplianceBatchJobReasonDeactivated is an user that has been deactivated
+	ComplianceBatchJobReasonDeactivated ComplianceBatchJobReason = "deactivated"
+	// ComplianceBatchJobReasonSuspended is a tweet or user that has been suspended
+	ComplianceBatchJobReasonSuspended ComplianceBatchJobReason = "suspended"
+	// ComplianceBatchJobReasonProtected is a tweet or user that has been protected
+	ComplianceBatchJobReasonProtected ComplianceBatchJobReason = "protected"
+	// ComplianceBatchJobReasonScrubGeo is a tweet or user that has had the geo removed
+	ComplianceBatchJobReasonScrubGeo ComplianceBatchJobReason = "scrub_geo"
+)
+
 // ComplianceBatchJobResult is the downloaded result
 type ComplianceBatchJobResult struct {
 	ID         string `json:"id"`
@@ -45,6 +72,26 @@ type ComplianceBatchJobResult struct {
 	Reason     string `json:"reason"`
 }
 
+// ActionType returns the typed action
+func (r ComplianceBatchJobResult) ActionType() ComplianceBatchJobAction {
+	return ComplianceBatchJobAction(r.Action)
+}
+
+// ReasonType returns the typed reason
+func (r ComplianceBatchJobResult) ReasonType() ComplianceBatchJobReason {
+	return ComplianceBatchJobReason(r.Reason)
+}
+
+// CreatedAtTime returns the created at as a time
+func (r ComplianceBatchJobResult) CreatedAtTime() (time.Time, error) {
+	return parseTime(r.CreatedAt)
+}
+
+// RedactedAtTime returns the redacted at as a time
+func (r ComplianceBatchJobResult) RedactedAtTime() (time.Time, error) {
+	return parseTime(r.RedactedAt)
+}
+
 // ComplianceBatchJobDownloadResponse is the response from dowload results
 type ComplianceBatchJobDownloadResponse struct {
 	Results   []*ComplianceBatchJobResult
@@ -67,6 +114,21 @@ type ComplianceBatchJobObj struct {
 	client            *http.Client
 }
 
+// CreatedAtTime returns the created at as a time
+func (c ComplianceBatchJobObj) CreatedAtTime() (time.Time, error) {
+	return parseTime(c.CreatedAt)
+}
+
+// UploadExpiresAtTime returns the upload expires at as a time
+func (c ComplianceBatchJobObj) UploadExpiresAtTime() (time.Time, error) {
+	return parseTime(c.UploadExpiresAt)
+}
+
+// DownloadExpiresAtTime returns the download expires at as a time
+func (c ComplianceBatchJobObj) DownloadExpiresAtTime() (time.Time, error) {
+	return parseTime(c.DownloadExpiresAt)
+}
+
 // Upload will upload ids from a reader
 func (c ComplianceBatchJobObj) Upload(ctx context.Context, ids io.Reader) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.UploadURL, ids)
@@ -102,8 +164,52 @@ func (c ComplianceBatchJobObj) Upload(ctx context.Context, ids io.Reader) error
 	return nil
 }
 
-// Download will download the results of the job
+// Download will download the results of the job.  All of the results are held in memory, for large jobs
+// DownloadIterator or DownloadStream will process each result as it is read.
 func (c ComplianceBatchJobObj) Download(ctx context.Context) (*ComplianceBatchJobDownloadResponse, error) {
+	iter, err := c.DownloadIterator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	results := []*ComplianceBatchJobResult{}
+	for iter.Next() {
+		results = append(results, iter.Result())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	return &ComplianceBatchJobDownloadResponse{
+		Results:   results,
+		RateLimit: iter.RateLimit,
+	}, nil
+}
+
+// DownloadStream will download the results of the job and call the handler for each result as it is read.  If
+// the handler returns an error, the download is stopped and the error is returned.
+func (c ComplianceBatchJobObj) DownloadStream(ctx context.Context, handler func(*ComplianceBatchJobResult) error) (*RateLimit, error) {
+	iter, err := c.DownloadIterator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		if err := handler(iter.Result()); err != nil {
+			return iter.RateLimit, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return iter.RateLimit, err
+	}
+	return iter.RateLimit, nil
+}
+
+// DownloadIterator will start the download of the results of the job, the results are read one at a time
+// with the iterator.  The iterator must be closed.
+func (c ComplianceBatchJobObj) DownloadIterator(ctx context.Context) (*ComplianceBatchJobResultIterator, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.DownloadURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("compliance batch job download request: %w", err)
@@ -113,11 +219,11 @@ func (c ComplianceBatchJobObj) Download(ctx context.Context) (*ComplianceBatchJo
 	if err != nil {
 		return nil, fmt.Errorf("compliance batch job download response: %w", err)
 	}
-	defer resp.Body.Close()
 
 	rl := rateFromHeader(resp.Header)
 
 	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
 		e := &ErrorResponse{}
 		if err := json.NewDecoder(resp.Body).Decode(e); err != nil {
 			return nil, &HTTPError{
@@ -132,27 +238,66 @@ func (c ComplianceBatchJobObj) Download(ctx context.Context) (*ComplianceBatchJo
 		return nil, e
 	}
 
-	results := []*ComplianceBatchJobResult{}
-
 	scanner := bufio.NewScanner(resp.Body)
 	scanner.Split(batchResultsSeparator)
-	for scanner.Scan() {
+	return &ComplianceBatchJobResultIterator{
+		RateLimit: rl,
+		body:      resp.Body,
+		scanner:   scanner,
+	}, nil
+}
+
+// ComplianceBatchJobResultIterator reads the downloaded results one at a time
+type ComplianceBatchJobResultIterator struct {
+	RateLimit *RateLimit
+	body      io.ReadCloser
+	scanner   *bufio.Scanner
+	result    *ComplianceBatchJobResult
+	err       error
+}
+
+// Next will read the next result, false is returned when there are no more results or there is an error
+func (i *ComplianceBatchJobResultIterator) Next() bool {
+	i.result = nil
+	if i.err != nil {
+		return false
+	}
+	for i.scanner.Scan() {
+		line := i.scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
 		result := &ComplianceBatchJobResult{}
-		if err := json.Unmarshal(scanner.Bytes(), result); err != nil {
-			return nil, &ResponseDecodeError{
+		if err := json.Unmarshal(line, result); err != nil {
+			i.err = &ResponseDecodeError{
 				Name:      "compliance batch job download",
 				Err:       err,
-				RateLimit: rl,
+				RateLimit: i.RateLimit,
 			}
+			return false
 		}
-		results = append(results, result)
+		i.result = result
+		return true
 	}
+	if err := i.scanner.Err(); err != nil {
+		i.err = fmt.Errorf("compliance batch job download read: %w", err)
+	}
+	return false
+}
 
-	return &ComplianceBatchJobDownloadResponse{
-		Results:   results,
-		RateLimit: rl,
-	}, nil
+// Result returns the current result
+func (i *ComplianceBatchJobResultIterator) Result() *ComplianceBatchJobResult {
+	return i.result
+}
+
+// Err returns the error that stopped the iterator
+func (i *ComplianceBatchJobResultIterator) Err() error {
+	return i.err
+}
 
+// Close will close the download
+func (i *ComplianceBatchJobResultIterator) Close() error {
+	return i.body.Close()
 }
 
 func batchResultsSeparator(data []byte, atEOF bool) (int, []byte, error) {
@@ -2,12 +2,14 @@ package twitter
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log"
 	"net/http"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestComplianceBatchJobObj_Upload(t *testing.T) {
@@ -164,3 +166,119 @@ func TestComplianceBatchJobObj_Download(t *testing.T) {
 		})
 	}
 }
+
+func TestComplianceBatchJobObj_DownloadStream(t *testing.T) {
+	closed := false
+	c := ComplianceBatchJobObj{
+		DownloadURL: "https://wwww.test.com/download",
+		client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.String(), "https://wwww.test.com/download") == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), "https://wwww.test.com/download")
+			}
+			results := `{"id":"1265324480517361664","action":"delete","created_at":"2019-10-29T17:02:47.000Z","redacted_at":"2020-07-29T17:02:47.000Z","reason":"deleted"}`
+			results += "\r\n"
+			results += `{"id":"1263926741774581761","action":"scrub_geo","created_at":"2019-10-29T17:02:47.000Z","redacted_at":"2020-07-29T17:02:47.000Z","reason":"scrub_geo"}`
+			results += "\r\n"
+			results += `{"id":"1265324480517361669","action":"delete","created_at":"2019-10-29T17:02:47.000Z","redacted_at":"2020-07-29T17:02:47.000Z","reason":"suspended"}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: &mockReadCloser{
+					Reader: strings.NewReader(results),
+					close:  func() { closed = true },
+				},
+				Header: http.Header{},
+			}
+		}),
+	}
+
+	type result struct {
+		id     string
+		action ComplianceBatchJobAction
+		reason ComplianceBatchJobReason
+	}
+	results := []result{}
+	stop := errors.New("stop")
+	_, err := c.DownloadStream(context.Background(), func(r *ComplianceBatchJobResult) error {
+		results = append(results, result{
+			id:     r.ID,
+			action: r.ActionType(),
+			reason: r.ReasonType(),
+		})
+		if len(results) == 2 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("ComplianceBatchJobObj.DownloadStream() error = %v, want %v", err, stop)
+	}
+	want := []result{
+		{
+			id:     "1265324480517361664",
+			action: ComplianceBatchJobActionDelete,
+			reason: ComplianceBatchJobReasonDeleted,
+		},
+		{
+			id:     "1263926741774581761",
+			action: ComplianceBatchJobActionScrubGeo,
+			reason: ComplianceBatchJobReasonScrubGeo,
+		},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("ComplianceBatchJobObj.DownloadStream() = %v, want %v", results, want)
+	}
+	if !closed {
+		t.Errorf("ComplianceBatchJobObj.DownloadStream() the download was not closed")
+	}
+}
+
+func TestComplianceBatchJobObj_DownloadIterator(t *testing.T) {
+	c := ComplianceBatchJobObj{
+		DownloadURL: "https://wwww.test.com/download",
+		client: mockHTTPClient(func(req *http.Request) *http.Response {
+			results := `{"id":"1265324480517361664","action":"delete","created_at":"2019-10-29T17:02:47.000Z","redacted_at":"2020-07-29T17:02:47.000Z","reason":"deleted"}`
+			results += "\r\n"
+			results += `not json`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(results)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	iter, err := c.DownloadIterator(context.Background())
+	if err != nil {
+		t.Fatalf("ComplianceBatchJobObj.DownloadIterator() error = %v", err)
+	}
+	defer iter.Close()
+
+	if !iter.Next() {
+		t.Fatalf("ComplianceBatchJobObj.DownloadIterator() want result, error = %v", iter.Err())
+	}
+	createdAt, err := iter.Result().CreatedAtTime()
+	if err != nil || !createdAt.Equal(time.Date(2019, time.October, 29, 17, 2, 47, 0, time.UTC)) {
+		t.Errorf("ComplianceBatchJobResult.CreatedAtTime() = %v, %v", createdAt, err)
+	}
+	redactedAt, err := iter.Result().RedactedAtTime()
+	if err != nil || !redactedAt.Equal(time.Date(2020, time.July, 29, 17, 2, 47, 0, time.UTC)) {
+		t.Errorf("ComplianceBatchJobResult.RedactedAtTime() = %v, %v", redactedAt, err)
+	}
+
+	if iter.Next() {
+		t.Errorf("ComplianceBatchJobObj.DownloadIterator() want decode error")
+	}
+	decodeErr := &ResponseDecodeError{}
+	if !errors.As(iter.Err(), &decodeErr) {
+		t.Errorf("ComplianceBatchJobObj.DownloadIterator() error = %v, want decode error", iter.Err())
+	}
+}
+
+type mockReadCloser struct {
+	io.Reader
+	close func()
+}
+
+func (m *mockReadCloser) Close() error {
+	m.close()
+	return nil
+}
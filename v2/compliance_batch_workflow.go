@@ -0,0 +1,344 @@
+package twitter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	complianceBatchWorkflowMinPoll       = 10 * time.Second
+	complianceBatchWorkflowMaxPoll       = 2 * time.Minute
+	complianceBatchWorkflowUploadRetries = 2
+)
+
+// ComplianceBatchIDSource is the source of the tweet or user ids for the compliance batch job.  NextID will
+// return io.EOF when there are no more ids.
+type ComplianceBatchIDSource interface {
+	NextID() (string, error)
+}
+
+// ComplianceBatchIDIterator is a function that is used as the id source.  It will return io.EOF when there
+// are no more ids.
+type ComplianceBatchIDIterator func() (string, error)
+
+// NextID will return the next id from the iterator
+func (i ComplianceBatchIDIterator) NextID() (string, error) {
+	return i()
+}
+
+// ComplianceBatchIDsFromSlice returns an id source from the slice
+func ComplianceBatchIDsFromSlice(ids []string) ComplianceBatchIDSource {
+	idx := 0
+	return ComplianceBatchIDIterator(func() (string, error) {
+		if idx >= len(ids) {
+			return "", io.EOF
+		}
+		id := ids[idx]
+		idx++
+		return id, nil
+	})
+}
+
+// ComplianceBatchIDsFromReader returns an id source from a reader with one id per line
+func ComplianceBatchIDsFromReader(r io.Reader) ComplianceBatchIDSource {
+	scanner := bufio.NewScanner(r)
+	return ComplianceBatchIDIterator(func() (string, error) {
+		for scanner.Scan() {
+			if id := strings.TrimSpace(scanner.Text()); len(id) > 0 {
+				return id, nil
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	})
+}
+
+// ComplianceBatchResultHandler is called for each of the downloaded results, returning an error will stop the workflow
+type ComplianceBatchResultHandler func(*ComplianceBatchJobResult) error
+
+// ComplianceBatchCheckpointState is the job that has been uploaded and is waiting for the results
+type ComplianceBatchCheckpointState struct {
+	JobID string                 `json:"job_id"`
+	Type  ComplianceBatchJobType `json:"type"`
+}
+
+// ComplianceBatchCheckpoint stores the uploaded job so the workflow can be resumed if the process restarts
+// between the upload and the download.  Load will return nil if there is no job.
+type ComplianceBatchCheckpoint interface {
+	Load() (*ComplianceBatchCheckpointState, error)
+	Save(state *ComplianceBatchCheckpointState) error
+	Clear() error
+}
+
+// ComplianceBatchFileCheckpoint is a checkpoint that is stored as a JSON file
+type ComplianceBatchFileCheckpoint struct {
+	Path string
+}
+
+// Load will read the checkpoint file, if the file does not exist there is no job
+func (f ComplianceBatchFileCheckpoint) Load() (*ComplianceBatchCheckpointState, error) {
+	enc, err := os.ReadFile(f.Path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("compliance batch checkpoint load: %w", err)
+	default:
+	}
+	state := &ComplianceBatchCheckpointState{}
+	if err := json.Unmarshal(enc, state); err != nil {
+		return nil, fmt.Errorf("compliance batch checkpoint decode: %w", err)
+	}
+	return state, nil
+}
+
+// Save will write the checkpoint file, the file is replaced so a partial write will not be loaded
+func (f ComplianceBatchFileCheckpoint) Save(state *ComplianceBatchCheckpointState) error {
+	enc, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("compliance batch checkpoint encode: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".*")
+	if err != nil {
+		return fmt.Errorf("compliance batch checkpoint save: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(enc); err != nil {
+		tmp.Close()
+		return fmt.Errorf("compliance batch checkpoint save: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("compliance batch checkpoint save: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.Path); err != nil {
+		return fmt.Errorf("compliance batch checkpoint save: %w", err)
+	}
+	return nil
+}
+
+// Clear will remove the checkpoint file
+func (f ComplianceBatchFileCheckpoint) Clear() error {
+	if err := os.Remove(f.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("compliance batch checkpoint clear: %w", err)
+	}
+	return nil
+}
+
+// ComplianceBatchWorkflowOpts are the options for the compliance batch workflow.
+//
+// Name and Resumable are used to create the job.
+//
+// MinPollInterval and MaxPollInterval are the bounds of the backoff while waiting for the job to complete.
+//
+// UploadRetries are the number of times a new job is created when the upload URL has expired.
+//
+// Checkpoint is used to resume the workflow when the process restarts after the ids have been uploaded.
+type ComplianceBatchWorkflowOpts struct {
+	Name            string
+	Resumable       bool
+	MinPollInterval time.Duration
+	MaxPollInterval time.Duration
+	UploadRetries   int
+	Checkpoint      ComplianceBatchCheckpoint
+}
+
+// ComplianceBatchWorkflowResponse is the response from the compliance batch workflow
+type ComplianceBatchWorkflowResponse struct {
+	Job         *ComplianceBatchJobObj
+	ResultCount int
+	Resumed     bool
+}
+
+// ComplianceBatchJobError is returned when the job has failed or expired
+type ComplianceBatchJobError struct {
+	Job *ComplianceBatchJobObj
+}
+
+func (e *ComplianceBatchJobError) Error() string {
+	if len(e.Job.Error) > 0 {
+		return fmt.Sprintf("compliance batch job %s %s: %s", e.Job.ID, e.Job.Status, e.Job.Error)
+	}
+	return fmt.Sprintf("compliance batch job %s %s", e.Job.ID, e.Job.Status)
+}
+
+type complianceBatchWorkflow struct {
+	client  *Client
+	jobType ComplianceBatchJobType
+	opts    ComplianceBatchWorkflowOpts
+	now     func() time.Time
+}
+
+func (w *complianceBatchWorkflow) run(ctx context.Context, ids ComplianceBatchIDSource, handler ComplianceBatchResultHandler) (*ComplianceBatchWorkflowResponse, error) {
+	job, err := w.resume(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resumed := job != nil
+
+	if job == nil {
+		if job, err = w.upload(ctx, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	if job, err = w.poll(ctx, job); err != nil {
+		return nil, err
+	}
+
+	count := 0
+	if _, err := job.DownloadStream(ctx, func(result *ComplianceBatchJobResult) error {
+		count++
+		return handler(result)
+	}); err != nil {
+		return nil, err
+	}
+
+	if w.opts.Checkpoint != nil {
+		if err := w.opts.Checkpoint.Clear(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ComplianceBatchWorkflowResponse{
+		Job:         job,
+		ResultCount: count,
+		Resumed:     resumed,
+	}, nil
+}
+
+// resume will return the checkpoint job, if the job is no longer usable the checkpoint is cleared and a new job will be created
+func (w *complianceBatchWorkflow) resume(ctx context.Context) (*ComplianceBatchJobObj, error) {
+	if w.opts.Checkpoint == nil {
+		return nil, nil
+	}
+	state, err := w.opts.Checkpoint.Load()
+	if err != nil || state == nil {
+		return nil, err
+	}
+	if state.Type != w.jobType || len(state.JobID) == 0 {
+		return nil, w.opts.Checkpoint.Clear()
+	}
+	resp, err := w.client.ComplianceBatchJob(ctx, state.JobID)
+	if err != nil {
+		return nil, fmt.Errorf("compliance batch workflow resume: %w", err)
+	}
+	switch resp.Raw.Job.Status {
+	case ComplianceBatchJobStatusExpired, ComplianceBatchJobStatusFailed:
+		return nil, w.opts.Checkpoint.Clear()
+	default:
+	}
+	return resp.Raw.Job, nil
+}
+
+// upload will create the job and upload the ids, if the upload URL has expired then a new job is created
+func (w *complianceBatchWorkflow) upload(ctx context.Context, ids ComplianceBatchIDSource) (*ComplianceBatchJobObj, error) {
+	// the ids are buffered so they can be uploaded again if the job has to be recreated
+	buf := &bytes.Buffer{}
+	for {
+		id, err := ids.NextID()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("compliance batch workflow ids: %w", err)
+		}
+		buf.WriteString(id)
+		buf.WriteString("\n")
+	}
+	if buf.Len() == 0 {
+		return nil, fmt.Errorf("compliance batch workflow: ids are required %w", ErrParameter)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := w.client.CreateComplianceBatchJob(ctx, w.jobType, CreateComplianceBatchJobOpts{
+			Name:      w.opts.Name,
+			Resumable: w.opts.Resumable,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("compliance batch workflow create: %w", err)
+		}
+		job := resp.Raw.Job
+
+		if w.uploadExpired(job) {
+			if attempt < w.opts.UploadRetries {
+				continue
+			}
+			return nil, fmt.Errorf("compliance batch workflow upload: job %s upload url expired", job.ID)
+		}
+
+		err = job.Upload(ctx, bytes.NewReader(buf.Bytes()))
+		switch {
+		case err == nil:
+		case attempt < w.opts.UploadRetries && w.uploadExpired(job):
+			continue
+		default:
+			return nil, fmt.Errorf("compliance batch workflow upload: %w", err)
+		}
+
+		if w.opts.Checkpoint != nil {
+			if err := w.opts.Checkpoint.Save(&ComplianceBatchCheckpointState{
+				JobID: job.ID,
+				Type:  w.jobType,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		return job, nil
+	}
+}
+
+func (w *complianceBatchWorkflow) uploadExpired(job *ComplianceBatchJobObj) bool {
+	expires, err := job.UploadExpiresAtTime()
+	if err != nil || expires.IsZero() {
+		return false
+	}
+	return !w.now().Before(expires)
+}
+
+// poll will wait for the job to be complete, backing off between each of the job lookups
+func (w *complianceBatchWorkflow) poll(ctx context.Context, job *ComplianceBatchJobObj) (*ComplianceBatchJobObj, error) {
+	interval := w.opts.MinPollInterval
+	for {
+		switch job.Status {
+		case ComplianceBatchJobStatusComplete:
+			return job, nil
+		case ComplianceBatchJobStatusFailed, ComplianceBatchJobStatusExpired:
+			if w.opts.Checkpoint != nil {
+				if err := w.opts.Checkpoint.Clear(); err != nil {
+					return nil, err
+				}
+			}
+			return nil, &ComplianceBatchJobError{
+				Job: job,
+			}
+		default:
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("compliance batch workflow poll: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+		interval *= 2
+		if interval > w.opts.MaxPollInterval {
+			interval = w.opts.MaxPollInterval
+		}
+
+		resp, err := w.client.ComplianceBatchJob(ctx, job.ID)
+		if err != nil {
+			return nil, fmt.Errorf("compliance batch workflow poll: %w", err)
+		}
+		job = resp.Raw.Job
+	}
+}
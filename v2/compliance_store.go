@@ -0,0 +1,419 @@
+package twitter
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const complianceFileStoreMaxLine = 1024 * 1024
+
+// ComplianceStore is the local dataset of tweets, users and likes that the compliance actions are applied to.  The
+// operations should not return an error if the record is not in the store.
+type ComplianceStore interface {
+	DeleteTweet(id string) error
+	DeleteUser(id string) error
+	DeleteLike(userID, tweetID string) error
+	RedactTweetGeo(tweetID string) error
+	RedactUserGeo(userID, upToTweetID string) error
+	MarkProtected(userID string, protected bool) error
+}
+
+// ComplianceMemoryStore is an in memory compliance store
+type ComplianceMemoryStore struct {
+	tweets map[string]*TweetObj
+	users  map[string]*UserObj
+	likes  map[string]map[string]struct{}
+	mutex  sync.RWMutex
+}
+
+// NewComplianceMemoryStore creates an empty in memory store
+func NewComplianceMemoryStore() *ComplianceMemoryStore {
+	return &ComplianceMemoryStore{
+		tweets: map[string]*TweetObj{},
+		users:  map[string]*UserObj{},
+		likes:  map[string]map[string]struct{}{},
+	}
+}
+
+// PutTweet will add or replace the tweet
+func (m *ComplianceMemoryStore) PutTweet(tweet *TweetObj) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.tweets[tweet.ID] = tweet
+	return nil
+}
+
+// PutUser will add or replace the user
+func (m *ComplianceMemoryStore) PutUser(user *UserObj) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.users[user.ID] = user
+	return nil
+}
+
+// PutLike will add the user's like of the tweet
+func (m *ComplianceMemoryStore) PutLike(userID, tweetID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if _, has := m.likes[userID]; !has {
+		m.likes[userID] = map[string]struct{}{}
+	}
+	m.likes[userID][tweetID] = struct{}{}
+	return nil
+}
+
+// Tweet returns the stored tweet or nil
+func (m *ComplianceMemoryStore) Tweet(id string) *TweetObj {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.tweets[id]
+}
+
+// User returns the stored user or nil
+func (m *ComplianceMemoryStore) User(id string) *UserObj {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.users[id]
+}
+
+// Liked returns if the user's like of the tweet is stored
+func (m *ComplianceMemoryStore) Liked(userID, tweetID string) bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	_, has := m.likes[userID][tweetID]
+	return has
+}
+
+// DeleteTweet will remove the tweet
+func (m *ComplianceMemoryStore) DeleteTweet(id string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.tweets, id)
+	return nil
+}
+
+// DeleteUser will remove the user, the user's tweets and the user's likes
+func (m *ComplianceMemoryStore) DeleteUser(id string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.users, id)
+	delete(m.likes, id)
+	for tweetID, tweet := range m.tweets {
+		if tweet.AuthorID == id {
+			delete(m.tweets, tweetID)
+		}
+	}
+	return nil
+}
+
+// DeleteLike will remove the user's like of the tweet
+func (m *ComplianceMemoryStore) DeleteLike(userID, tweetID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.likes[userID], tweetID)
+	return nil
+}
+
+// RedactTweetGeo will remove the geo from the tweet
+func (m *ComplianceMemoryStore) RedactTweetGeo(tweetID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if tweet, has := m.tweets[tweetID]; has {
+		tweet.Geo = nil
+	}
+	return nil
+}
+
+// RedactUserGeo will remove the geo from the user's tweets up to and including the tweet id.  If the tweet id is
+// not present, the geo is removed from all of the user's tweets.
+func (m *ComplianceMemoryStore) RedactUserGeo(userID, upToTweetID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, tweet := range m.tweets {
+		if tweet.AuthorID != userID {
+			continue
+		}
+		if len(upToTweetID) > 0 && compareTweetIDs(tweet.ID, upToTweetID) > 0 {
+			continue
+		}
+		tweet.Geo = nil
+	}
+	return nil
+}
+
+// MarkProtected will set if the user is protected
+func (m *ComplianceMemoryStore) MarkProtected(userID string, protected bool) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if user, has := m.users[userID]; has {
+		user.Protected = protected
+	}
+	return nil
+}
+
+// compareTweetIDs compares the numeric ids without parsing them, shorter ids are older
+func compareTweetIDs(a, b string) int {
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+type complianceFileLike struct {
+	UserID  string `json:"user_id"`
+	TweetID string `json:"tweet_id"`
+}
+
+type complianceFileOp struct {
+	Type        string `json:"type"`
+	ID          string `json:"id,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+	UpToTweetID string `json:"up_to_tweet_id,omitempty"`
+	Protected   bool   `json:"protected,omitempty"`
+}
+
+type complianceFileLine struct {
+	Tweet *TweetObj           `json:"tweet,omitempty"`
+	User  *UserObj            `json:"user,omitempty"`
+	Like  *complianceFileLike `json:"like,omitempty"`
+	Op    *complianceFileOp   `json:"op,omitempty"`
+}
+
+const (
+	complianceFileOpDeleteTweet    = "delete_tweet"
+	complianceFileOpDeleteUser     = "delete_user"
+	complianceFileOpDeleteLike     = "delete_like"
+	complianceFileOpRedactTweetGeo = "redact_tweet_geo"
+	complianceFileOpRedactUserGeo  = "redact_user_geo"
+	complianceFileOpMarkProtected  = "mark_protected"
+)
+
+// ComplianceFileStore is a compliance store that is kept in a JSONL file.  The records and the operations are
+// appended to the file and replayed when the file is opened.  The deleted and redacted data is left in the file
+// until Compact is called, which should be done after applying a batch of compliance actions.
+type ComplianceFileStore struct {
+	*ComplianceMemoryStore
+	path  string
+	file  *os.File
+	mutex sync.Mutex
+}
+
+// OpenComplianceFileStore will open, or create, the JSONL file and load the records
+func OpenComplianceFileStore(path string) (*ComplianceFileStore, error) {
+	f := &ComplianceFileStore{
+		ComplianceMemoryStore: NewComplianceMemoryStore(),
+		path:                  path,
+	}
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("compliance file store open: %w", err)
+	}
+	f.file = file
+	return f, nil
+}
+
+func (f *ComplianceFileStore) load() error {
+	file, err := os.Open(f.path)
+	switch {
+	case os.IsNotExist(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("compliance file store open: %w", err)
+	default:
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), complianceFileStoreMaxLine)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		line := &complianceFileLine{}
+		if err := json.Unmarshal(scanner.Bytes(), line); err != nil {
+			return fmt.Errorf("compliance file store decode: %w", err)
+		}
+		f.replay(line)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("compliance file store read: %w", err)
+	}
+	return nil
+}
+
+func (f *ComplianceFileStore) replay(line *complianceFileLine) {
+	switch {
+	case line.Tweet != nil:
+		f.ComplianceMemoryStore.PutTweet(line.Tweet)
+	case line.User != nil:
+		f.ComplianceMemoryStore.PutUser(line.User)
+	case line.Like != nil:
+		f.ComplianceMemoryStore.PutLike(line.Like.UserID, line.Like.TweetID)
+	case line.Op != nil:
+		switch line.Op.Type {
+		case complianceFileOpDeleteTweet:
+			f.ComplianceMemoryStore.DeleteTweet(line.Op.ID)
+		case complianceFileOpDeleteUser:
+			f.ComplianceMemoryStore.DeleteUser(line.Op.ID)
+		case complianceFileOpDeleteLike:
+			f.ComplianceMemoryStore.DeleteLike(line.Op.UserID, line.Op.ID)
+		case complianceFileOpRedactTweetGeo:
+			f.ComplianceMemoryStore.RedactTweetGeo(line.Op.ID)
+		case complianceFileOpRedactUserGeo:
+			f.ComplianceMemoryStore.RedactUserGeo(line.Op.UserID, line.Op.UpToTweetID)
+		case complianceFileOpMarkProtected:
+			f.ComplianceMemoryStore.MarkProtected(line.Op.UserID, line.Op.Protected)
+		default:
+		}
+	default:
+	}
+}
+
+// apply will append the line to the file and then update the records
+func (f *ComplianceFileStore) apply(line *complianceFileLine) error {
+	enc, err := json.Marshal(line)
+	if err != nil {
+		return fmt.Errorf("compliance file store encode: %w", err)
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if _, err := f.file.Write(append(enc, '\n')); err != nil {
+		return fmt.Errorf("compliance file store write: %w", err)
+	}
+	f.replay(line)
+	return nil
+}
+
+// PutTweet will add or replace the tweet
+func (f *ComplianceFileStore) PutTweet(tweet *TweetObj) error {
+	return f.apply(&complianceFileLine{Tweet: tweet})
+}
+
+// PutUser will add or replace the user
+func (f *ComplianceFileStore) PutUser(user *UserObj) error {
+	return f.apply(&complianceFileLine{User: user})
+}
+
+// PutLike will add the user's like of the tweet
+func (f *ComplianceFileStore) PutLike(userID, tweetID string) error {
+	return f.apply(&complianceFileLine{Like: &complianceFileLike{UserID: userID, TweetID: tweetID}})
+}
+
+// DeleteTweet will remove the tweet
+func (f *ComplianceFileStore) DeleteTweet(id string) error {
+	return f.apply(&complianceFileLine{Op: &complianceFileOp{Type: complianceFileOpDeleteTweet, ID: id}})
+}
+
+// DeleteUser will remove the user, the user's tweets and the user's likes
+func (f *ComplianceFileStore) DeleteUser(id string) error {
+	return f.apply(&complianceFileLine{Op: &complianceFileOp{Type: complianceFileOpDeleteUser, ID: id}})
+}
+
+// DeleteLike will remove the user's like of the tweet
+func (f *ComplianceFileStore) DeleteLike(userID, tweetID string) error {
+	return f.apply(&complianceFileLine{Op: &complianceFileOp{Type: complianceFileOpDeleteLike, ID: tweetID, UserID: userID}})
+}
+
+// RedactTweetGeo will remove the geo from the tweet
+func (f *ComplianceFileStore) RedactTweetGeo(tweetID string) error {
+	return f.apply(&complianceFileLine{Op: &complianceFileOp{Type: complianceFileOpRedactTweetGeo, ID: tweetID}})
+}
+
+// RedactUserGeo will remove the geo from the user's tweets up to and including the tweet id
+func (f *ComplianceFileStore) RedactUserGeo(userID, upToTweetID string) error {
+	return f.apply(&complianceFileLine{Op: &complianceFileOp{Type: complianceFileOpRedactUserGeo, UserID: userID, UpToTweetID: upToTweetID}})
+}
+
+// MarkProtected will set if the user is protected
+func (f *ComplianceFileStore) MarkProtected(userID string, protected bool) error {
+	return f.apply(&complianceFileLine{Op: &complianceFileOp{Type: complianceFileOpMarkProtected, UserID: userID, Protected: protected}})
+}
+
+// Compact will rewrite the file with only the remaining records, so the deleted and redacted data is removed
+// from the disk.  If the rewrite fails, the store keeps appending to the current file.
+func (f *ComplianceFileStore) Compact() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.rewrite()
+}
+
+// rewrite will replace the file with the remaining records, the file mutex must be held
+func (f *ComplianceFileStore) rewrite() error {
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return fmt.Errorf("compliance file store compact: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	f.ComplianceMemoryStore.mutex.RLock()
+	for _, user := range f.users {
+		if err = encoder.Encode(&complianceFileLine{User: user}); err != nil {
+			break
+		}
+	}
+	for _, tweet := range f.tweets {
+		if err != nil {
+			break
+		}
+		err = encoder.Encode(&complianceFileLine{Tweet: tweet})
+	}
+	for userID, tweets := range f.likes {
+		for tweetID := range tweets {
+			if err != nil {
+				break
+			}
+			err = encoder.Encode(&complianceFileLine{Like: &complianceFileLike{UserID: userID, TweetID: tweetID}})
+		}
+	}
+	f.ComplianceMemoryStore.mutex.RUnlock()
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("compliance file store compact: %w", err)
+	}
+
+	// the new file is opened before the rename, so the current file is kept if either fails
+	file, err := os.OpenFile(tmp.Name(), os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("compliance file store compact: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		file.Close()
+		return fmt.Errorf("compliance file store compact: %w", err)
+	}
+	previous := f.file
+	f.file = file
+	previous.Close()
+	return nil
+}
+
+// Close will close the file
+func (f *ComplianceFileStore) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.file.Close()
+}
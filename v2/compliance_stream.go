@@ -0,0 +1,256 @@
+package twitter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ComplianceStreamEventType is the type of compliance stream event
+type ComplianceStreamEventType string
+
+const (
+	// ComplianceStreamEventDelete is a tweet or like that has been deleted
+	ComplianceStreamEventDelete ComplianceStreamEventType = "delete"
+	// ComplianceStreamEventWithheld is a tweet that has been withheld
+	ComplianceStreamEventWithheld ComplianceStreamEventType = "withheld"
+	// ComplianceStreamEventScrubGeo is the geo that has been removed from an user's tweets
+	ComplianceStreamEventScrubGeo ComplianceStreamEventType = "scrub_geo"
+	// ComplianceStreamEventDrop is a tweet that has been dropped
+	ComplianceStreamEventDrop ComplianceStreamEventType = "drop"
+	// ComplianceStreamEventUndrop is a tweet that has been undropped
+	ComplianceStreamEventUndrop ComplianceStreamEventType = "undrop"
+	// ComplianceStreamEventTweetEdit is a tweet that has been edited
+	ComplianceStreamEventTweetEdit ComplianceStreamEventType = "tweet_edit"
+	// ComplianceStreamEventUserDelete is an user that has been deleted
+	ComplianceStreamEventUserDelete ComplianceStreamEventType = "user_delete"
+	// ComplianceStreamEventUserUndelete is an user that has been undeleted
+	ComplianceStreamEventUserUndelete ComplianceStreamEventType = "user_undelete"
+	// ComplianceStreamEventUserWithheld is an user that has been withheld
+	ComplianceStreamEventUserWithheld ComplianceStreamEventType = "user_withheld"
+	// ComplianceStreamEventUserProtect is an user that has been protected
+	ComplianceStreamEventUserProtect ComplianceStreamEventType = "user_protect"
+	// ComplianceStreamEventUserUnprotect is an user that has been unprotected
+	ComplianceStreamEventUserUnprotect ComplianceStreamEventType = "user_unprotect"
+	// ComplianceStreamEventUserSuspend is an user that has been suspended
+	ComplianceStreamEventUserSuspend ComplianceStreamEventType = "user_suspend"
+	// ComplianceStreamEventUserUnsuspend is an user that has been unsuspended
+	ComplianceStreamEventUserUnsuspend ComplianceStreamEventType = "user_unsuspend"
+	// ComplianceStreamEventUserProfileModification is an user that has modified their profile
+	ComplianceStreamEventUserProfileModification ComplianceStreamEventType = "user_profile_modification"
+
+	// ComplianceStreamErrorType represents the compliance stream errors
+	ComplianceStreamErrorType StreamErrorType = "compliance"
+
+	tweetComplianceStreamMaxPartition = 4
+	userComplianceStreamMaxPartition  = 4
+	likeComplianceStreamMaxPartition  = 8
+)
+
+// ComplianceStreamOpts are the options for the compliance streams.  The partition is required.
+type ComplianceStreamOpts struct {
+	Partition       int
+	BackfillMinutes int
+	StartTime       time.Time
+	EndTime         time.Time
+}
+
+func (c ComplianceStreamOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	q.Add("partition", strconv.Itoa(c.Partition))
+	if c.BackfillMinutes > 0 {
+		q.Add("backfill_minutes", strconv.Itoa(c.BackfillMinutes))
+	}
+	if !c.StartTime.IsZero() {
+		q.Add("start_time", c.StartTime.Format(time.RFC3339))
+	}
+	if !c.EndTime.IsZero() {
+		q.Add("end_time", c.EndTime.Format(time.RFC3339))
+	}
+	req.URL.RawQuery = q.Encode()
+}
+
+// ComplianceStreamTweetObj is the tweet of the compliance event
+type ComplianceStreamTweetObj struct {
+	ID       string `json:"id"`
+	AuthorID string `json:"author_id"`
+}
+
+// ComplianceStreamUserObj is the user of the compliance event
+type ComplianceStreamUserObj struct {
+	ID string `json:"id"`
+}
+
+// ComplianceStreamFavoriteObj is the like of the compliance event
+type ComplianceStreamFavoriteObj struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// ComplianceStreamEvent is an event from the tweets, users or likes compliance streams.  The type of the event
+// will determine which of the fields are present.
+type ComplianceStreamEvent struct {
+	Type                ComplianceStreamEventType    `json:"-"`
+	EventAt             time.Time                    `json:"event_at"`
+	Tweet               *ComplianceStreamTweetObj    `json:"tweet,omitempty"`
+	User                *ComplianceStreamUserObj     `json:"user,omitempty"`
+	Favorite            *ComplianceStreamFavoriteObj `json:"favorite,omitempty"`
+	WithheldInCountries []string                     `json:"withheld_in_countries,omitempty"`
+	UpToTweetID         string                       `json:"up_to_tweet_id,omitempty"`
+	InitialTweetID      string                       `json:"initial_tweet_id,omitempty"`
+	EditTweetIDs        []string                     `json:"edit_tweet_ids,omitempty"`
+	ProfileField        string                       `json:"profile_field,omitempty"`
+	NewValue            string                       `json:"new_value,omitempty"`
+}
+
+// complianceStreamFrame is used to classify and decode a compliance stream message in a single pass.
+type complianceStreamFrame struct {
+	Data map[ComplianceStreamEventType]*ComplianceStreamEvent `json:"data"`
+	streamControl
+}
+
+// ComplianceStream is the compliance stream handler.  It shares the framing and keep alive of the tweet stream.
+// The events are not dropped, so the stream will wait on a slow consumer until the event is received or the
+// stream is closed.
+type ComplianceStream struct {
+	events        chan *ComplianceStreamEvent
+	system        chan map[SystemMessageType]SystemMessage
+	disconnection chan *DisconnectionError
+	close         chan bool
+	closeOnce     sync.Once
+	err           chan error
+	alive         bool
+	mutex         sync.RWMutex
+	RateLimit     *RateLimit
+}
+
+// StartComplianceStream will start the compliance streaming
+func StartComplianceStream(stream io.ReadCloser) *ComplianceStream {
+	cs := &ComplianceStream{
+		events:        make(chan *ComplianceStreamEvent, 10),
+		system:        make(chan map[SystemMessageType]SystemMessage, 10),
+		disconnection: make(chan *DisconnectionError, 10),
+		close:         make(chan bool),
+		err:           make(chan error),
+		mutex:         sync.RWMutex{},
+		alive:         true,
+	}
+
+	go cs.handle(stream)
+
+	return cs
+}
+
+func (cs *ComplianceStream) heartbeat(beat bool) {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+	cs.alive = beat
+}
+
+// Connection returns if the connect is still alive
+func (cs *ComplianceStream) Connection() bool {
+	cs.mutex.RLock()
+	defer cs.mutex.RUnlock()
+	return cs.alive
+}
+
+func (cs *ComplianceStream) handle(stream io.ReadCloser) {
+	defer stream.Close()
+	defer close(cs.events)
+	defer close(cs.system)
+	defer close(cs.err)
+
+	scanStream(stream, cs.close, cs.heartbeat, cs.handleFrame)
+}
+
+func (cs *ComplianceStream) handleFrame(msg []byte) {
+	msg, err := normalizeStream(msg)
+	if err != nil {
+		cs.sendErr(fmt.Errorf("stream error: normalize error %w", err))
+		return
+	}
+
+	frame := &complianceStreamFrame{}
+	if err := json.Unmarshal(msg, frame); err != nil {
+		cs.sendErr(&StreamError{
+			Type: ComplianceStreamErrorType,
+			Msg:  "unmarshal compliance stream",
+			Err:  err,
+		})
+		return
+	}
+
+	if len(frame.Data) > 0 {
+		for eventType, event := range frame.Data {
+			if event == nil {
+				continue
+			}
+			event.Type = eventType
+			select {
+			case cs.events <- event:
+			case <-cs.close:
+				return
+			}
+		}
+		return
+	}
+
+	switch frame.streamType() {
+	case systemMsgStream:
+		select {
+		case cs.system <- frame.systemMessage():
+		default:
+		}
+	case disconnectionErrs, disconnectionErr:
+		ds, err := frame.disconnectionError()
+		if err != nil {
+			cs.sendErr(err)
+			return
+		}
+		select {
+		case cs.disconnection <- ds:
+		default:
+		}
+	default:
+		cs.sendErr(fmt.Errorf("stream error: unmarshal error %w", errors.New("decode stream message")))
+	}
+}
+
+func (cs *ComplianceStream) sendErr(err error) {
+	select {
+	case cs.err <- err:
+	default:
+	}
+}
+
+// Events will return the channel to receive compliance events
+func (cs *ComplianceStream) Events() <-chan *ComplianceStreamEvent {
+	return cs.events
+}
+
+// SystemMessages will return the channel to receive system stream messages
+func (cs *ComplianceStream) SystemMessages() <-chan map[SystemMessageType]SystemMessage {
+	return cs.system
+}
+
+// DisconnectionError will return the channel to receive disconnect error messages
+func (cs *ComplianceStream) DisconnectionError() <-chan *DisconnectionError {
+	return cs.disconnection
+}
+
+// Err will return the channel to receive any stream errors
+func (cs *ComplianceStream) Err() <-chan error {
+	return cs.err
+}
+
+// Close will close the stream and all channels
+func (cs *ComplianceStream) Close() {
+	cs.closeOnce.Do(func() {
+		close(cs.close)
+	})
+}
@@ -0,0 +1,69 @@
+package twitter
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	acceptEncodingHeader  = "Accept-Encoding"
+	contentEncodingHeader = "Content-Encoding"
+	gzipEncoding          = "gzip"
+)
+
+// do will send the request.  If compression is enabled, gzip will be requested and the response body
+// will be decompressed as it is read, which allows for streams to be decoded without buffering.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	if !c.Compression {
+		return c.Client.Do(req)
+	}
+	req.Header.Set(acceptEncodingHeader, gzipEncoding)
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(resp.Header.Get(contentEncodingHeader), gzipEncoding) {
+		return resp, nil
+	}
+	resp.Body = &gzipReadCloser{
+		body: resp.Body,
+	}
+	resp.Header.Del(contentEncodingHeader)
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return resp, nil
+}
+
+// gzipReadCloser lazily creates the gzip reader, as creating it will block on reading the gzip header
+type gzipReadCloser struct {
+	body   io.ReadCloser
+	reader *gzip.Reader
+	err    error
+}
+
+func (g *gzipReadCloser) Read(p []byte) (int, error) {
+	if g.err != nil {
+		return 0, g.err
+	}
+	if g.reader == nil {
+		reader, err := gzip.NewReader(g.body)
+		if err != nil {
+			g.err = fmt.Errorf("gzip response body: %w", err)
+			return 0, g.err
+		}
+		g.reader = reader
+	}
+	return g.reader.Read(p)
+}
+
+func (g *gzipReadCloser) Close() error {
+	if g.reader != nil {
+		g.reader.Close()
+	}
+	return g.body.Close()
+}
@@ -0,0 +1,220 @@
+package twitter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func gzipBody(body string) io.ReadCloser {
+	buf := &bytes.Buffer{}
+	zw := gzip.NewWriter(buf)
+	if _, err := zw.Write([]byte(body)); err != nil {
+		log.Panicf("gzip write error %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		log.Panicf("gzip close error %v", err)
+	}
+	return io.NopCloser(buf)
+}
+
+func TestClient_Compression(t *testing.T) {
+	body := `{
+		"data": [
+			{
+				"id": "1",
+				"text": "hello"
+			}
+		],
+		"meta": {
+			"result_count": 1,
+			"next_token": "token"
+		}
+	}`
+	tests := []struct {
+		name        string
+		compression bool
+		encoding    string
+		body        func() io.ReadCloser
+		want        *TweetRecentSearchResponse
+		wantErr     bool
+	}{
+		{
+			name:        "gzip response",
+			compression: true,
+			encoding:    gzipEncoding,
+			body: func() io.ReadCloser {
+				return gzipBody(body)
+			},
+			want: &TweetRecentSearchResponse{
+				Raw: &TweetRaw{
+					Tweets: []*TweetObj{
+						{
+							ID:   "1",
+							Text: "hello",
+						},
+					},
+				},
+				Meta: &TweetRecentSearchMeta{
+					ResultCount: 1,
+					NextToken:   "token",
+				},
+			},
+		},
+		{
+			name:        "plain response",
+			compression: true,
+			body: func() io.ReadCloser {
+				return io.NopCloser(strings.NewReader(body))
+			},
+			want: &TweetRecentSearchResponse{
+				Raw: &TweetRaw{
+					Tweets: []*TweetObj{
+						{
+							ID:   "1",
+							Text: "hello",
+						},
+					},
+				},
+				Meta: &TweetRecentSearchMeta{
+					ResultCount: 1,
+					NextToken:   "token",
+				},
+			},
+		},
+		{
+			name:        "not requested",
+			compression: false,
+			body: func() io.ReadCloser {
+				return io.NopCloser(strings.NewReader(body))
+			},
+			want: &TweetRecentSearchResponse{
+				Raw: &TweetRaw{
+					Tweets: []*TweetObj{
+						{
+							ID:   "1",
+							Text: "hello",
+						},
+					},
+				},
+				Meta: &TweetRecentSearchMeta{
+					ResultCount: 1,
+					NextToken:   "token",
+				},
+			},
+		},
+		{
+			name:        "bad gzip response",
+			compression: true,
+			encoding:    gzipEncoding,
+			body: func() io.ReadCloser {
+				return io.NopCloser(strings.NewReader(body))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					accept := req.Header.Get(acceptEncodingHeader)
+					if tt.compression && accept != gzipEncoding {
+						log.Panicf("the accept encoding is not correct %s", accept)
+					}
+					if !tt.compression && accept != "" {
+						log.Panicf("the accept encoding should not be set %s", accept)
+					}
+					h := http.Header{}
+					if len(tt.encoding) > 0 {
+						h.Add(contentEncodingHeader, tt.encoding)
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       tt.body(),
+						Header:     h,
+					}
+				}),
+				Compression: tt.compression,
+			}
+			got, err := c.TweetRecentSearch(context.Background(), "query", TweetRecentSearchOpts{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.TweetRecentSearch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.TweetRecentSearch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CompressionStream(t *testing.T) {
+	pr, pw := io.Pipe()
+	zw := gzip.NewWriter(pw)
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Header.Get(acceptEncodingHeader) != gzipEncoding {
+				log.Panicf("the accept encoding is not correct %s", req.Header.Get(acceptEncodingHeader))
+			}
+			h := http.Header{}
+			h.Add(contentEncodingHeader, gzipEncoding)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       pr,
+				Header:     h,
+			}
+		}),
+		Compression: true,
+	}
+
+	writeFrame := func(frame string) {
+		if _, err := zw.Write([]byte(frame + "\r\n")); err != nil {
+			log.Panicf("gzip write error %v", err)
+		}
+		if err := zw.Flush(); err != nil {
+			log.Panicf("gzip flush error %v", err)
+		}
+	}
+	go writeFrame(`{"data":{"id":"1","text":"hello"}}`)
+
+	stream, err := c.TweetSampleStream(context.Background(), TweetSampleStreamOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetSampleStream() error = %v", err)
+	}
+	defer stream.Close()
+	defer pw.Close()
+
+	// the writer is still open, so the tweet must be decoded from the flushed data only
+	timer := time.NewTimer(time.Second * 5)
+	select {
+	case msg := <-stream.Tweets():
+		want := &TweetMessage{
+			Raw: &TweetRaw{
+				Tweets: []*TweetObj{
+					{
+						ID:   "1",
+						Text: "hello",
+					},
+				},
+			},
+		}
+		if !reflect.DeepEqual(msg, want) {
+			t.Errorf("Client.TweetSampleStream() tweet = %v, want %v", msg, want)
+		}
+	case err := <-stream.Err():
+		t.Errorf("Client.TweetSampleStream() error %v", err)
+	case <-timer.C:
+		t.Errorf("Client.TweetSampleStream() timed out waiting for the tweet")
+	}
+}
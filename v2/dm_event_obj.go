@@ -0,0 +1,89 @@
+package twitter
+
+import "time"
+
+// DMEventField are the direct message event fields that can be included in the response
+type DMEventField string
+
+const (
+	// DMEventFieldID is the unique identifier of the event
+	DMEventFieldID DMEventField = "id"
+	// DMEventFieldText is the text of the message
+	DMEventFieldText DMEventField = "text"
+	// DMEventFieldEventType is the type of the event
+	DMEventFieldEventType DMEventField = "event_type"
+	// DMEventFieldCreatedAt is the creation time of the event
+	DMEventFieldCreatedAt DMEventField = "created_at"
+	// DMEventFieldConversationID is the unique identifier of the conversation the event is a part of
+	DMEventFieldConversationID DMEventField = "dm_conversation_id"
+	// DMEventFieldSenderID is the unique identifier of the user that sent the message
+	DMEventFieldSenderID DMEventField = "sender_id"
+	// DMEventFieldParticipantIDs are the unique identifiers of the users that joined or left the conversation
+	DMEventFieldParticipantIDs DMEventField = "participant_ids"
+	// DMEventFieldReferencedTweets are the tweets that are shared in the message
+	DMEventFieldReferencedTweets DMEventField = "referenced_tweets"
+	// DMEventFieldAttachments are the media attached to the message
+	DMEventFieldAttachments DMEventField = "attachments"
+)
+
+func dmEventFieldStringArray(arr []DMEventField) []string {
+	strs := make([]string, len(arr))
+	for i, field := range arr {
+		strs[i] = string(field)
+	}
+	return strs
+}
+
+// DMEventType is the type of direct message event
+type DMEventType string
+
+const (
+	// DMEventTypeMessageCreate is a message sent to the conversation
+	DMEventTypeMessageCreate DMEventType = "MessageCreate"
+	// DMEventTypeParticipantsJoin is an user joining the conversation
+	DMEventTypeParticipantsJoin DMEventType = "ParticipantsJoin"
+	// DMEventTypeParticipantsLeave is an user leaving the conversation
+	DMEventTypeParticipantsLeave DMEventType = "ParticipantsLeave"
+)
+
+func dmEventTypeStringArray(arr []DMEventType) []string {
+	strs := make([]string, len(arr))
+	for i, eventType := range arr {
+		strs[i] = string(eventType)
+	}
+	return strs
+}
+
+// DMEventObj is a direct message event
+type DMEventObj struct {
+	ID               string                       `json:"id"`
+	Text             string                       `json:"text,omitempty"`
+	EventType        DMEventType                  `json:"event_type"`
+	CreatedAt        string                       `json:"created_at,omitempty"`
+	ConversationID   string                       `json:"dm_conversation_id,omitempty"`
+	SenderID         string                       `json:"sender_id,omitempty"`
+	ParticipantIDs   []string                     `json:"participant_ids,omitempty"`
+	ReferencedTweets []*DMEventReferencedTweetObj `json:"referenced_tweets,omitempty"`
+	Attachments      *DMEventAttachmentsObj       `json:"attachments,omitempty"`
+}
+
+// CreatedAtTime returns the created at as a time
+func (d DMEventObj) CreatedAtTime() (time.Time, error) {
+	return parseTime(d.CreatedAt)
+}
+
+// DMEventReferencedTweetObj is a tweet shared in the message
+type DMEventReferencedTweetObj struct {
+	ID string `json:"id"`
+}
+
+// DMEventAttachmentsObj are the media attached to the message
+type DMEventAttachmentsObj struct {
+	MediaKeys []string `json:"media_keys"`
+}
+
+// DMConversationObj is the conversation and the event created by sending a message
+type DMConversationObj struct {
+	ID      string `json:"dm_conversation_id"`
+	EventID string `json:"dm_event_id"`
+}
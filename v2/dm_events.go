@@ -0,0 +1,129 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const dmConversationTypeGroup = "Group"
+
+// DMEventsLookupOpts are the options for the direct message events lookups
+type DMEventsLookupOpts struct {
+	EventTypes      []DMEventType
+	Expansions      []Expansion
+	DMEventFields   []DMEventField
+	MediaFields     []MediaField
+	TweetFields     []TweetField
+	UserFields      []UserField
+	MaxResults      int
+	PaginationToken string
+}
+
+func (d DMEventsLookupOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if len(d.EventTypes) > 0 {
+		q.Add("event_types", strings.Join(dmEventTypeStringArray(d.EventTypes), ","))
+	}
+	if len(d.Expansions) > 0 {
+		q.Add("expansions", strings.Join(expansionStringArray(d.Expansions), ","))
+	}
+	if len(d.DMEventFields) > 0 {
+		q.Add("dm_event.fields", strings.Join(dmEventFieldStringArray(d.DMEventFields), ","))
+	}
+	if len(d.MediaFields) > 0 {
+		q.Add("media.fields", strings.Join(mediaFieldStringArray(d.MediaFields), ","))
+	}
+	if len(d.TweetFields) > 0 {
+		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(d.TweetFields), ","))
+	}
+	if len(d.UserFields) > 0 {
+		q.Add("user.fields", strings.Join(userFieldStringArray(d.UserFields), ","))
+	}
+	if d.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(d.MaxResults))
+	}
+	if len(d.PaginationToken) > 0 {
+		q.Add("pagination_token", d.PaginationToken)
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// DMEventsRaw is the raw response from the direct message events lookups
+type DMEventsRaw struct {
+	Events   []*DMEventObj       `json:"data"`
+	Includes *DMEventRawIncludes `json:"includes,omitempty"`
+	Errors   []*ErrorObj         `json:"errors,omitempty"`
+}
+
+// DMEventRawIncludes are the includes from the expansions
+type DMEventRawIncludes struct {
+	Users  []*UserObj  `json:"users,omitempty"`
+	Tweets []*TweetObj `json:"tweets,omitempty"`
+	Media  []*MediaObj `json:"media,omitempty"`
+}
+
+// DMEventsMeta is the meta data from the direct message events lookups
+type DMEventsMeta struct {
+	ResultCount   int    `json:"result_count"`
+	NextToken     string `json:"next_token"`
+	PreviousToken string `json:"previous_token"`
+}
+
+// DMEventsLookupResponse is the response from the direct message events lookups
+type DMEventsLookupResponse struct {
+	Raw       *DMEventsRaw
+	Meta      *DMEventsMeta `json:"meta"`
+	RateLimit *RateLimit
+}
+
+// CreateDMAttachment is the media to attach to the message
+type CreateDMAttachment struct {
+	MediaID string `json:"media_id"`
+}
+
+// CreateDMMessage is the message to send.  The text is required if there are no attachments.
+type CreateDMMessage struct {
+	Text        string               `json:"text,omitempty"`
+	Attachments []CreateDMAttachment `json:"attachments,omitempty"`
+}
+
+func (m CreateDMMessage) validate() error {
+	if len(m.Text) == 0 && len(m.Attachments) == 0 {
+		return fmt.Errorf("direct message text is required if there are no attachments %w", ErrParameter)
+	}
+	for _, attachment := range m.Attachments {
+		if len(attachment.MediaID) == 0 {
+			return fmt.Errorf("direct message attachment media id is required %w", ErrParameter)
+		}
+	}
+	return nil
+}
+
+// CreateDMMessageResponse is the response from sending a message
+type CreateDMMessageResponse struct {
+	Data      *DMConversationObj `json:"data"`
+	RateLimit *RateLimit
+}
+
+// CreateDMGroupConversationRequest is the group conversation to create with the first message
+type CreateDMGroupConversationRequest struct {
+	ParticipantIDs []string
+	Message        CreateDMMessage
+}
+
+func (g CreateDMGroupConversationRequest) validate() error {
+	if len(g.ParticipantIDs) == 0 {
+		return fmt.Errorf("group conversation participant ids are required %w", ErrParameter)
+	}
+	return g.Message.validate()
+}
+
+// CreateDMGroupConversationResponse is the response from creating a group conversation
+type CreateDMGroupConversationResponse struct {
+	Data      *DMConversationObj `json:"data"`
+	RateLimit *RateLimit
+}
@@ -52,9 +52,33 @@ const (
 	spaceSearchEndpoint                           endpoint = "2/spaces/search"
 	complianceJobsEndpoint                        endpoint = "2/compliance/jobs"
 	quoteTweetLookupEndpoint                      endpoint = "2/tweets/{id}/quote_tweets"
+	tweetRetweetsLookupEndpoint                   endpoint = "2/tweets/{id}/retweets"
+	userRepostsOfMeEndpoint                       endpoint = "2/users/reposts_of_me"
+	userSearchEndpoint                            endpoint = "2/users/search"
+	trendsByWOEIDEndpoint                         endpoint = "2/trends/by/woeid/{id}"
 	tweetBookmarksEndpoint                        endpoint = "2/users/{id}/bookmarks"
+	tweetComplianceStreamEndpoint                 endpoint = "2/tweets/compliance/stream"
+	userComplianceStreamEndpoint                  endpoint = "2/users/compliance/stream"
+	likeComplianceStreamEndpoint                  endpoint = "2/likes/compliance/stream"
+	tweetFirehoseStreamEndpoint                   endpoint = "2/tweets/firehose/stream"
+	tweetSample10StreamEndpoint                   endpoint = "2/tweets/sample10/stream"
+	dmEventsEndpoint                              endpoint = "2/dm_events"
+	dmConversationEventsEndpoint                  endpoint = "2/dm_conversations/{id}/dm_events"
+	dmParticipantEventsEndpoint                   endpoint = "2/dm_conversations/with/{id}/dm_events"
+	dmConversationMessagesEndpoint                endpoint = "2/dm_conversations/{id}/messages"
+	dmParticipantMessagesEndpoint                 endpoint = "2/dm_conversations/with/{id}/messages"
+	dmConversationsEndpoint                       endpoint = "2/dm_conversations"
+	mediaUploadEndpoint                           endpoint = "1.1/media/upload.json"
+	mediaMetadataEndpoint                         endpoint = "1.1/media/metadata/create.json"
+	usageTweetsEndpoint                           endpoint = "2/usage/tweets"
+	accountActivityWebhooksEndpoint               endpoint = "1.1/account_activity/all/{env}/webhooks.json"
+	accountActivityWebhookEndpoint                endpoint = "1.1/account_activity/all/{env}/webhooks/{id}.json"
+	accountActivitySubscriptionsEndpoint          endpoint = "1.1/account_activity/all/{env}/subscriptions.json"
+	accountActivitySubscriptionEndpoint           endpoint = "1.1/account_activity/all/{env}/subscriptions/{id}.json"
+	accountActivitySubscriptionsListEndpoint      endpoint = "1.1/account_activity/all/{env}/subscriptions/list.json"
 
-	idTag = "{id}"
+	idTag  = "{id}"
+	envTag = "{env}"
 )
 
 func (e endpoint) url(host string) string {
@@ -65,3 +89,9 @@ func (e endpoint) urlID(host, id string) string {
 	u := fmt.Sprintf("%s/%s", host, string(e))
 	return strings.ReplaceAll(u, idTag, id)
 }
+
+func (e endpoint) urlEnv(host, env, id string) string {
+	u := fmt.Sprintf("%s/%s", host, string(e))
+	u = strings.ReplaceAll(u, envTag, env)
+	return strings.ReplaceAll(u, idTag, id)
+}
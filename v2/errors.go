@@ -1,6 +1,47 @@
 package twitter
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 // ErrParameter will indicate that the error is from an invalid input parameter
 var ErrParameter = errors.New("twitter input parameter error")
+
+// FieldError is an invalid field of a request, which wraps ErrParameter
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (f *FieldError) Error() string {
+	return f.Field + ": " + f.Message
+}
+
+// Unwrap will return ErrParameter
+func (f *FieldError) Unwrap() error {
+	return ErrParameter
+}
+
+// FieldErrors are all of the invalid fields of a request, which wraps ErrParameter
+type FieldErrors []*FieldError
+
+func (f FieldErrors) Error() string {
+	msgs := make([]string, len(f))
+	for i, err := range f {
+		msgs[i] = err.Error()
+	}
+	return "invalid fields [" + strings.Join(msgs, ", ") + "]"
+}
+
+// Unwrap will return ErrParameter
+func (f FieldErrors) Unwrap() error {
+	return ErrParameter
+}
+
+func (f FieldErrors) err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return f
+}
@@ -20,6 +20,8 @@ const (
 	ExpansionReferencedTweetsID Expansion = "referenced_tweets.id"
 	// ExpansionReferencedTweetsIDAuthorID returns a user object for the author of the referenced Tweet
 	ExpansionReferencedTweetsIDAuthorID Expansion = "referenced_tweets.id.author_id"
+	// ExpansionEditHistoryTweetIDs returns the Tweet objects of each version of an edited Tweet
+	ExpansionEditHistoryTweetIDs Expansion = "edit_history_tweet_ids"
 	// ExpansionPinnedTweetID returns a Tweet object representing the Tweet pinned to the top of the user’s profile
 	ExpansionPinnedTweetID Expansion = "pinned_tweet_id"
 	// ExpansionOwnerID returns an owner in the includes
@@ -32,6 +34,10 @@ const (
 	ExpansionInvitedUserIDs Expansion = "invited_user_ids"
 	// ExpansionHostIDs returns the host ids
 	ExpansionHostIDs Expansion = "host_ids"
+	// ExpansionSenderID returns the user that sent the direct message
+	ExpansionSenderID Expansion = "sender_id"
+	// ExpansionParticipantIDs returns the users that joined or left the direct message conversation
+	ExpansionParticipantIDs Expansion = "participant_ids"
 )
 
 func expansionStringArray(arr []Expansion) []string {
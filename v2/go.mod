@@ -1,3 +1,5 @@
 module github.com/g8rswimmer/go-twitter/v2
 
 go 1.17
+
+require golang.org/x/text v0.13.0
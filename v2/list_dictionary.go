@@ -0,0 +1,31 @@
+package twitter
+
+// ListDictionary is a struct of a list and all of the reference objects
+type ListDictionary struct {
+	List  ListObj
+	Owner *UserObj
+}
+
+// CreateListDictionary will create a dictionary from a list and the includes
+func CreateListDictionary(list ListObj, includes *ListRawIncludes) *ListDictionary {
+	dictionary := &ListDictionary{
+		List: list,
+	}
+	if includes == nil {
+		return dictionary
+	}
+
+	if user, has := includes.UsersByID()[list.OwnerID]; has {
+		dictionary.Owner = user
+	}
+
+	return dictionary
+}
+
+func createListDictionaries(lists []*ListObj, includes *ListRawIncludes) map[string]*ListDictionary {
+	dictionaries := map[string]*ListDictionary{}
+	for _, list := range lists {
+		dictionaries[list.ID] = CreateListDictionary(*list, includes)
+	}
+	return dictionaries
+}
@@ -0,0 +1,70 @@
+package twitter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCreateListDictionary(t *testing.T) {
+	owner := &UserObj{ID: "2244994945", Name: "Twitter Dev", UserName: "TwitterDev"}
+	list := ListObj{
+		ID:      "1441162269824405510",
+		Name:    "Test List",
+		OwnerID: "2244994945",
+	}
+	type args struct {
+		list     ListObj
+		includes *ListRawIncludes
+	}
+	tests := []struct {
+		name string
+		args args
+		want *ListDictionary
+	}{
+		{
+			name: "success",
+			args: args{
+				list: list,
+				includes: &ListRawIncludes{
+					Users: []*UserObj{owner},
+				},
+			},
+			want: &ListDictionary{
+				List:  list,
+				Owner: owner,
+			},
+		},
+		{
+			name: "owner not included",
+			args: args{
+				list:     list,
+				includes: &ListRawIncludes{},
+			},
+			want: &ListDictionary{
+				List: list,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CreateListDictionary(tt.args.list, tt.args.includes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateListDictionary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserListRaw_ListDictionaries(t *testing.T) {
+	raw := &UserListRaw{
+		Lists: []*ListObj{
+			{ID: "1441162269824405510", OwnerID: "2244994945"},
+		},
+		Includes: &ListRawIncludes{
+			Users: []*UserObj{{ID: "2244994945", UserName: "TwitterDev"}},
+		},
+	}
+	dictionary, has := raw.ListDictionaries()["1441162269824405510"]
+	if !has || dictionary.Owner == nil || dictionary.Owner.UserName != "TwitterDev" {
+		t.Errorf("UserListRaw.ListDictionaries() = %v", dictionary)
+	}
+}
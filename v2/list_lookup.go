@@ -36,9 +36,36 @@ type ListRaw struct {
 	Errors   []*ErrorObj      `json:"errors,omitempty"`
 }
 
+// ListDictionary creates the list dictionary from the raw list response, nil if there is no list
+func (l *ListRaw) ListDictionary() *ListDictionary {
+	if l.List == nil {
+		return nil
+	}
+	return CreateListDictionary(*l.List, l.Includes)
+}
+
 // ListRawIncludes the data include from the expansion
 type ListRawIncludes struct {
-	Users []*UserObj `json:"users,omitempty"`
+	Users   []*UserObj `json:"users,omitempty"`
+	userIDs map[string]*UserObj
+}
+
+// UsersByID will return a map of user ids to object
+func (l *ListRawIncludes) UsersByID() map[string]*UserObj {
+	switch {
+	case l.userIDs == nil:
+		return l.usersByID()
+	default:
+		return l.userIDs
+	}
+}
+
+func (l *ListRawIncludes) usersByID() map[string]*UserObj {
+	l.userIDs = map[string]*UserObj{}
+	for _, user := range l.Users {
+		l.userIDs[user.ID] = user
+	}
+	return l.userIDs
 }
 
 // ListLookupResponse is the response from the list lookup
@@ -80,9 +107,19 @@ func (l UserListLookupOpts) addQuery(req *http.Request) {
 
 // UserListRaw is the raw response
 type UserListRaw struct {
-	Lists    []*ListObj       `json:"data"`
-	Includes *ListRawIncludes `json:"includes,omitempty"`
-	Errors   []*ErrorObj      `json:"errors,omitempty"`
+	Lists        []*ListObj       `json:"data"`
+	Includes     *ListRawIncludes `json:"includes,omitempty"`
+	Errors       []*ErrorObj      `json:"errors,omitempty"`
+	dictionaries map[string]*ListDictionary
+}
+
+// ListDictionaries create a map of list dictionaries from the raw list response
+func (l *UserListRaw) ListDictionaries() map[string]*ListDictionary {
+	if l.dictionaries != nil {
+		return l.dictionaries
+	}
+	l.dictionaries = createListDictionaries(l.Lists, l.Includes)
+	return l.dictionaries
 }
 
 // UserListLookupResponse is the raw response with meta
@@ -101,36 +138,14 @@ type UserListLookupMeta struct {
 
 //ListTweetLookupOpts are the response field options
 type ListTweetLookupOpts struct {
-	Expansions      []Expansion
-	TweetFields     []TweetField
-	UserFields      []UserField
-	MediaFields     []MediaField
-	PlaceFields     []PlaceField
-	PollFields      []PollField
+	TweetSelection
 	MaxResults      int
 	PaginationToken string
 }
 
 func (l ListTweetLookupOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(l.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(l.Expansions), ","))
-	}
-	if len(l.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(l.TweetFields), ","))
-	}
-	if len(l.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(l.UserFields), ","))
-	}
-	if len(l.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(l.MediaFields), ","))
-	}
-	if len(l.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(l.PlaceFields), ","))
-	}
-	if len(l.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(l.PollFields), ","))
-	}
+	l.TweetSelection.addQuery(q)
 	if l.MaxResults > 0 {
 		q.Add("max_results", strconv.Itoa(l.MaxResults))
 	}
@@ -189,9 +204,19 @@ func (l UserListMembershipsOpts) addQuery(req *http.Request) {
 
 // UserListMembershipsRaw the raw data from the user list memberships
 type UserListMembershipsRaw struct {
-	Lists    []*ListObj       `json:"data"`
-	Includes *ListRawIncludes `json:"includes,omitempty"`
-	Errors   []*ErrorObj      `json:"errors,omitempty"`
+	Lists        []*ListObj       `json:"data"`
+	Includes     *ListRawIncludes `json:"includes,omitempty"`
+	Errors       []*ErrorObj      `json:"errors,omitempty"`
+	dictionaries map[string]*ListDictionary
+}
+
+// ListDictionaries create a map of list dictionaries from the raw list response
+func (l *UserListMembershipsRaw) ListDictionaries() map[string]*ListDictionary {
+	if l.dictionaries != nil {
+		return l.dictionaries
+	}
+	l.dictionaries = createListDictionaries(l.Lists, l.Includes)
+	return l.dictionaries
 }
 
 // UserListMembershipsMeta the response meta data
@@ -285,9 +310,19 @@ type UserPinnedListsResponse struct {
 
 // UserPinnedListsRaw the raw data for pinned lists
 type UserPinnedListsRaw struct {
-	Lists    []*ListObj       `json:"data"`
-	Includes *ListRawIncludes `json:"includes,omitempty"`
-	Errors   []*ErrorObj      `json:"errors,omitempty"`
+	Lists        []*ListObj       `json:"data"`
+	Includes     *ListRawIncludes `json:"includes,omitempty"`
+	Errors       []*ErrorObj      `json:"errors,omitempty"`
+	dictionaries map[string]*ListDictionary
+}
+
+// ListDictionaries create a map of list dictionaries from the raw list response
+func (l *UserPinnedListsRaw) ListDictionaries() map[string]*ListDictionary {
+	if l.dictionaries != nil {
+		return l.dictionaries
+	}
+	l.dictionaries = createListDictionaries(l.Lists, l.Includes)
+	return l.dictionaries
 }
 
 // UserPinnedListsMeta the meta for pinned lists
@@ -335,9 +370,19 @@ type UserFollowedListsResponse struct {
 
 // UserFollowedListsRaw is the raw response for the user followed
 type UserFollowedListsRaw struct {
-	Lists    []*ListObj       `json:"data"`
-	Includes *ListRawIncludes `json:"includes,omitempty"`
-	Errors   []*ErrorObj      `json:"errors,omitempty"`
+	Lists        []*ListObj       `json:"data"`
+	Includes     *ListRawIncludes `json:"includes,omitempty"`
+	Errors       []*ErrorObj      `json:"errors,omitempty"`
+	dictionaries map[string]*ListDictionary
+}
+
+// ListDictionaries create a map of list dictionaries from the raw list response
+func (l *UserFollowedListsRaw) ListDictionaries() map[string]*ListDictionary {
+	if l.dictionaries != nil {
+		return l.dictionaries
+	}
+	l.dictionaries = createListDictionaries(l.Lists, l.Includes)
+	return l.dictionaries
 }
 
 // UserFollowedListsMeta is the meta for the user followed
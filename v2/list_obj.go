@@ -1,5 +1,7 @@
 package twitter
 
+import "time"
+
 // ListField are the optional fields that can be included in the response
 type ListField string
 
@@ -37,3 +39,8 @@ type ListObj struct {
 	Private       bool   `json:"private"`
 	OwnerID       string `json:"owner_id"`
 }
+
+// CreatedAtTime returns the created at as a time
+func (l ListObj) CreatedAtTime() (time.Time, error) {
+	return parseTime(l.CreatedAt)
+}
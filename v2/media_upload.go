@@ -0,0 +1,178 @@
+package twitter
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// MediaCategory is the use case of the uploaded media
+type MediaCategory string
+
+const (
+	// MediaCategoryTweetImage is an image attached to a tweet
+	MediaCategoryTweetImage MediaCategory = "tweet_image"
+	// MediaCategoryTweetGIF is an animated gif attached to a tweet
+	MediaCategoryTweetGIF MediaCategory = "tweet_gif"
+	// MediaCategoryTweetVideo is a video attached to a tweet
+	MediaCategoryTweetVideo MediaCategory = "tweet_video"
+	// MediaCategoryDMImage is an image attached to a direct message
+	MediaCategoryDMImage MediaCategory = "dm_image"
+	// MediaCategoryDMGIF is an animated gif attached to a direct message
+	MediaCategoryDMGIF MediaCategory = "dm_gif"
+	// MediaCategoryDMVideo is a video attached to a direct message
+	MediaCategoryDMVideo MediaCategory = "dm_video"
+)
+
+// MediaProcessingState is the state of the media processing after the upload is finalized
+type MediaProcessingState string
+
+const (
+	// MediaProcessingStatePending is media waiting to be processed
+	MediaProcessingStatePending MediaProcessingState = "pending"
+	// MediaProcessingStateInProgress is media being processed
+	MediaProcessingStateInProgress MediaProcessingState = "in_progress"
+	// MediaProcessingStateFailed is media that could not be processed
+	MediaProcessingStateFailed MediaProcessingState = "failed"
+	// MediaProcessingStateSucceeded is media that is ready to be used
+	MediaProcessingStateSucceeded MediaProcessingState = "succeeded"
+)
+
+const (
+	mediaUploadDefaultHost      = "https://upload.twitter.com"
+	mediaUploadDefaultChunkSize = 1024 * 1024
+	mediaUploadMaxChunkSize     = 5 * 1024 * 1024
+	mediaAltTextMaxLength       = 1000
+	mediaUploadMinCheckAfter    = time.Second
+)
+
+// MediaUploadOpts are the options for the chunked media upload.
+//
+// MediaType is the MIME type of the media and is required.
+//
+// TotalBytes is the size of the media, if zero it will be taken from readers that have a length (bytes.Reader, strings.Reader, bytes.Buffer)
+//
+// ChunkSize is the size of each APPEND, the default is 1MB and the max is 5MB.
+//
+// AltText is set as the media metadata once the media has been processed
+type MediaUploadOpts struct {
+	MediaType        string
+	MediaCategory    MediaCategory
+	TotalBytes       int
+	ChunkSize        int
+	AltText          string
+	AdditionalOwners []string
+}
+
+func (m *MediaUploadOpts) validate(media io.Reader) error {
+	if len(m.MediaType) == 0 {
+		return fmt.Errorf("media type is required %w", ErrParameter)
+	}
+	if m.TotalBytes == 0 {
+		if l, ok := media.(interface{ Len() int }); ok {
+			m.TotalBytes = l.Len()
+		}
+	}
+	if m.TotalBytes <= 0 {
+		return fmt.Errorf("media total bytes is required %w", ErrParameter)
+	}
+	switch {
+	case m.ChunkSize == 0:
+		m.ChunkSize = mediaUploadDefaultChunkSize
+	case m.ChunkSize < 0 || m.ChunkSize > mediaUploadMaxChunkSize:
+		return fmt.Errorf("media chunk size [%d] must be between 1 and %d %w", m.ChunkSize, mediaUploadMaxChunkSize, ErrParameter)
+	}
+	if len([]rune(m.AltText)) > mediaAltTextMaxLength {
+		return fmt.Errorf("media alt text can not be more than %d characters %w", mediaAltTextMaxLength, ErrParameter)
+	}
+	return nil
+}
+
+// MediaUploadObj is the uploaded media
+type MediaUploadObj struct {
+	ID               string                  `json:"media_id_string"`
+	MediaKey         string                  `json:"media_key,omitempty"`
+	Size             int                     `json:"size,omitempty"`
+	ExpiresAfterSecs int                     `json:"expires_after_secs,omitempty"`
+	ProcessingInfo   *MediaProcessingInfoObj `json:"processing_info,omitempty"`
+}
+
+// MediaProcessingInfoObj is the processing status of the media
+type MediaProcessingInfoObj struct {
+	State           MediaProcessingState     `json:"state"`
+	CheckAfterSecs  int                      `json:"check_after_secs,omitempty"`
+	ProgressPercent int                      `json:"progress_percent,omitempty"`
+	Error           *MediaProcessingErrorObj `json:"error,omitempty"`
+}
+
+// checkAfter is how long to wait before checking the status, with a minimum so a missing value will not spin
+func (m *MediaProcessingInfoObj) checkAfter() time.Duration {
+	checkAfter := time.Duration(m.CheckAfterSecs) * time.Second
+	if checkAfter < mediaUploadMinCheckAfter {
+		return mediaUploadMinCheckAfter
+	}
+	return checkAfter
+}
+
+// MediaProcessingErrorObj is the reason the media could not be processed
+type MediaProcessingErrorObj struct {
+	Code    int    `json:"code"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// MediaProcessingError is returned when the media fails processing
+type MediaProcessingError struct {
+	MediaID string
+	Err     *MediaProcessingErrorObj
+}
+
+func (e *MediaProcessingError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("media %s processing failed", e.MediaID)
+	}
+	return fmt.Sprintf("media %s processing failed: %s %s", e.MediaID, e.Err.Name, e.Err.Message)
+}
+
+// MediaUploadResponse is the response from the media upload
+type MediaUploadResponse struct {
+	Media     *MediaUploadObj
+	RateLimit *RateLimit
+}
+
+// TweetMedia returns the media to attach to a CreateTweetRequest
+func (m *MediaUploadResponse) TweetMedia() *CreateTweetMedia {
+	return &CreateTweetMedia{
+		IDs: []string{m.Media.ID},
+	}
+}
+
+// MediaStatusResponse is the response from the media processing status
+type MediaStatusResponse struct {
+	Media     *MediaUploadObj
+	RateLimit *RateLimit
+}
+
+// CreateMediaMetadataRequest is the metadata to add to the uploaded media
+type CreateMediaMetadataRequest struct {
+	MediaID string
+	AltText string
+}
+
+func (m CreateMediaMetadataRequest) validate() error {
+	if len(m.MediaID) == 0 {
+		return fmt.Errorf("media id is required %w", ErrParameter)
+	}
+	if len(m.AltText) == 0 {
+		return fmt.Errorf("media alt text is required %w", ErrParameter)
+	}
+	if len([]rune(m.AltText)) > mediaAltTextMaxLength {
+		return fmt.Errorf("media alt text can not be more than %d characters %w", mediaAltTextMaxLength, ErrParameter)
+	}
+	return nil
+}
+
+// CreateMediaMetadataResponse is the response from adding metadata to the media
+type CreateMediaMetadataResponse struct {
+	RateLimit *RateLimit
+}
@@ -17,3 +17,12 @@ func mockHTTPClient(fn roundTripFunc) *http.Client {
 type mockAuth struct{}
 
 func (m *mockAuth) Add(*http.Request) {}
+
+type mockContextAuth struct {
+	mockAuth
+	authContext AuthContext
+}
+
+func (m *mockContextAuth) AuthContext() AuthContext {
+	return m.authContext
+}
@@ -1,5 +1,7 @@
 package twitter
 
+import "time"
+
 // PollField defines the fields of the expanded tweet
 type PollField string
 
@@ -33,6 +35,11 @@ type PollObj struct {
 	VotingStatus    string           `json:"voting_status,omitempty"`
 }
 
+// EndDateTimeTime returns the end date time as a time
+func (p PollObj) EndDateTimeTime() (time.Time, error) {
+	return parseTime(p.EndDateTime)
+}
+
 // PollOptionObj contains objects describing each choice in the referenced poll.
 type PollOptionObj struct {
 	Position int    `json:"position"`
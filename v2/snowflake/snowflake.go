@@ -0,0 +1,145 @@
+// Package snowflake has the utilities for the twitter snowflake ids.
+//
+// The tweet, user, list and space ids are snowflakes.  The id is a 64 bit integer that is made up of the
+// milliseconds since the twitter epoch, the datacenter, the worker and a sequence number.  Since the time is
+// the most significant part of the id, the ids can be compared and can be created from a time to be used as
+// the since and until ids of the search and timeline callouts.
+//
+// The ids that were created before November 2010 are not snowflakes and will not have a meaningful time.
+package snowflake
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// Epoch is the twitter epoch in milliseconds since the unix epoch
+	Epoch int64 = 1288834974657
+
+	timestampBits  = 41
+	datacenterBits = 5
+	workerBits     = 5
+	sequenceBits   = 12
+
+	workerShift     = sequenceBits
+	datacenterShift = sequenceBits + workerBits
+	timestampShift  = sequenceBits + workerBits + datacenterBits
+
+	maxTimestamp  = int64(1)<<timestampBits - 1
+	maxDatacenter = int64(1)<<datacenterBits - 1
+	maxWorker     = int64(1)<<workerBits - 1
+	maxSequence   = int64(1)<<sequenceBits - 1
+)
+
+// ErrInvalid will indicate that the id is not a valid snowflake
+var ErrInvalid = errors.New("snowflake invalid id")
+
+// ErrTime will indicate that the time can not be represented as a snowflake
+var ErrTime = errors.New("snowflake time out of range")
+
+// ID is a twitter snowflake id
+type ID int64
+
+// Parse will parse and validate the id, which must be a positive decimal integer
+func Parse(id string) (ID, error) {
+	if len(id) == 0 {
+		return 0, fmt.Errorf("snowflake parse: an id is required: %w", ErrInvalid)
+	}
+	v, err := strconv.ParseInt(id, 10, 64)
+	switch {
+	case err != nil:
+		return 0, fmt.Errorf("snowflake parse %s: %v: %w", id, err, ErrInvalid)
+	case v <= 0:
+		return 0, fmt.Errorf("snowflake parse %s: the id must be positive: %w", id, ErrInvalid)
+	default:
+	}
+	return ID(v), nil
+}
+
+// FromTime will return the smallest id that can be created at the time
+func FromTime(t time.Time) (ID, error) {
+	ms := t.UnixMilli() - Epoch
+	if ms < 0 || ms > maxTimestamp {
+		return 0, fmt.Errorf("snowflake from time %s: %w", t.Format(time.RFC3339), ErrTime)
+	}
+	return ID(ms << timestampShift), nil
+}
+
+// SinceID will return the since id that will include the ids created at or after the time
+func SinceID(t time.Time) (string, error) {
+	id, err := FromTime(t)
+	if err != nil {
+		return "", err
+	}
+	if id > 0 {
+		id--
+	}
+	return id.String(), nil
+}
+
+// UntilID will return the until id that will include the ids created before the time
+func UntilID(t time.Time) (string, error) {
+	id, err := FromTime(t)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// Compare will compare the ids numerically, returning -1, 0 or 1
+func Compare(a, b string) (int, error) {
+	idA, err := Parse(a)
+	if err != nil {
+		return 0, err
+	}
+	idB, err := Parse(b)
+	if err != nil {
+		return 0, err
+	}
+	return idA.Compare(idB), nil
+}
+
+// String returns the decimal id
+func (id ID) String() string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+// Timestamp is the milliseconds since the unix epoch that the id was created
+func (id ID) Timestamp() int64 {
+	return int64(id)>>timestampShift + Epoch
+}
+
+// Time is the time that the id was created
+func (id ID) Time() time.Time {
+	return time.UnixMilli(id.Timestamp()).UTC()
+}
+
+// Datacenter is the datacenter that created the id
+func (id ID) Datacenter() int {
+	return int(int64(id) >> datacenterShift & maxDatacenter)
+}
+
+// Worker is the worker that created the id
+func (id ID) Worker() int {
+	return int(int64(id) >> workerShift & maxWorker)
+}
+
+// Sequence is the sequence number of the id within the millisecond
+func (id ID) Sequence() int {
+	return int(int64(id) & maxSequence)
+}
+
+// Compare will return -1 if the id is less than the other, 0 if equal and 1 if greater
+func (id ID) Compare(other ID) int {
+	switch {
+	case id < other:
+		return -1
+	case id > other:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,153 @@
+package snowflake
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		wantTime       time.Time
+		wantDatacenter int
+		wantWorker     int
+		wantSequence   int
+		wantErr        error
+	}{
+		{
+			name:           "tweet id",
+			id:             "1460323737035677698",
+			wantTime:       time.Date(2021, time.November, 15, 19, 8, 5, int(69*time.Millisecond), time.UTC),
+			wantDatacenter: 10,
+			wantWorker:     18,
+			wantSequence:   2,
+		},
+		{
+			name:    "empty",
+			id:      "",
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "not a number",
+			id:      "12ab",
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "negative",
+			id:      "-1460323737035677698",
+			wantErr: ErrInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.String() != tt.id {
+				t.Errorf("ID.String() = %v, want %v", got.String(), tt.id)
+			}
+			if !got.Time().Equal(tt.wantTime) {
+				t.Errorf("ID.Time() = %v, want %v", got.Time(), tt.wantTime)
+			}
+			if got.Datacenter() != tt.wantDatacenter || got.Worker() != tt.wantWorker || got.Sequence() != tt.wantSequence {
+				t.Errorf("ID = datacenter %d worker %d sequence %d", got.Datacenter(), got.Worker(), got.Sequence())
+			}
+		})
+	}
+}
+
+func TestFromTime(t *testing.T) {
+	tests := []struct {
+		name      string
+		time      time.Time
+		wantSince string
+		wantUntil string
+		wantErr   error
+	}{
+		{
+			name:      "time",
+			time:      time.Date(2021, time.November, 15, 18, 0, 0, 0, time.UTC),
+			wantSince: "1460306603013046271",
+			wantUntil: "1460306603013046272",
+		},
+		{
+			name:    "before the epoch",
+			time:    time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: ErrTime,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			since, err := SinceID(tt.time)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SinceID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			until, err := UntilID(tt.time)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UntilID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if since != tt.wantSince || until != tt.wantUntil {
+				t.Errorf("SinceID() = %v UntilID() = %v, want %v %v", since, until, tt.wantSince, tt.wantUntil)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			id, _ := Parse(until)
+			if !id.Time().Equal(tt.time) {
+				t.Errorf("FromTime() time = %v, want %v", id.Time(), tt.time)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "less with fewer digits",
+			a:    "999999999999999999",
+			b:    "1460323737035677698",
+			want: -1,
+		},
+		{
+			name: "equal",
+			a:    "1460323737035677698",
+			b:    "1460323737035677698",
+			want: 0,
+		},
+		{
+			name: "greater",
+			a:    "1460323737035677699",
+			b:    "1460323737035677698",
+			want: 1,
+		},
+		{
+			name:    "invalid",
+			a:       "abc",
+			b:       "1460323737035677698",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compare(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
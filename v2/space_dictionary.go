@@ -0,0 +1,54 @@
+package twitter
+
+// SpaceDictionary is a struct of a space and all of the reference objects
+type SpaceDictionary struct {
+	Space        SpaceObj
+	Creator      *UserObj
+	Hosts        []*UserObj
+	Speakers     []*UserObj
+	InvitedUsers []*UserObj
+	Topics       []*TopicObj
+}
+
+// CreateSpaceDictionary will create a dictionary from a space and the includes
+func CreateSpaceDictionary(space SpaceObj, includes *SpacesRawIncludes) *SpaceDictionary {
+	dictionary := &SpaceDictionary{
+		Space:        space,
+		Hosts:        []*UserObj{},
+		Speakers:     []*UserObj{},
+		InvitedUsers: []*UserObj{},
+		Topics:       []*TopicObj{},
+	}
+	if includes == nil {
+		return dictionary
+	}
+
+	userIDs := includes.UsersByID()
+	if user, has := userIDs[space.CreatorID]; has {
+		dictionary.Creator = user
+	}
+	dictionary.Hosts = spaceDictionaryUsers(space.HostIDs, userIDs)
+	dictionary.Speakers = spaceDictionaryUsers(space.SpeakerIDs, userIDs)
+	dictionary.InvitedUsers = spaceDictionaryUsers(space.InvitedUserIDs, userIDs)
+
+	topicIDs := includes.TopicsByID()
+	topics := []*TopicObj{}
+	for _, id := range space.TopicIDs {
+		if topic, has := topicIDs[id]; has {
+			topics = append(topics, topic)
+		}
+	}
+	dictionary.Topics = topics
+
+	return dictionary
+}
+
+func spaceDictionaryUsers(ids []string, userIDs map[string]*UserObj) []*UserObj {
+	users := []*UserObj{}
+	for _, id := range ids {
+		if user, has := userIDs[id]; has {
+			users = append(users, user)
+		}
+	}
+	return users
+}
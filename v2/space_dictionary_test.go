@@ -0,0 +1,84 @@
+package twitter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCreateSpaceDictionary(t *testing.T) {
+	creator := &UserObj{ID: "2244994945", Name: "Twitter Dev", UserName: "TwitterDev"}
+	speaker := &UserObj{ID: "6253282", Name: "Twitter API", UserName: "TwitterAPI"}
+	topic := &TopicObj{ID: "848920371311001600", Name: "Technology", Description: "Technology and computing"}
+	space := SpaceObj{
+		ID:             "1DXxyRYNejbKM",
+		State:          "live",
+		CreatorID:      "2244994945",
+		HostIDs:        []string{"2244994945"},
+		SpeakerIDs:     []string{"2244994945", "6253282"},
+		InvitedUserIDs: []string{"783214"},
+		TopicIDs:       []string{"848920371311001600", "848921413196984320"},
+	}
+	type args struct {
+		space    SpaceObj
+		includes *SpacesRawIncludes
+	}
+	tests := []struct {
+		name string
+		args args
+		want *SpaceDictionary
+	}{
+		{
+			name: "success",
+			args: args{
+				space: space,
+				includes: &SpacesRawIncludes{
+					Users:  []*UserObj{creator, speaker},
+					Topics: []*TopicObj{topic},
+				},
+			},
+			want: &SpaceDictionary{
+				Space:        space,
+				Creator:      creator,
+				Hosts:        []*UserObj{creator},
+				Speakers:     []*UserObj{creator, speaker},
+				InvitedUsers: []*UserObj{},
+				Topics:       []*TopicObj{topic},
+			},
+		},
+		{
+			name: "no includes",
+			args: args{
+				space: space,
+			},
+			want: &SpaceDictionary{
+				Space:        space,
+				Hosts:        []*UserObj{},
+				Speakers:     []*UserObj{},
+				InvitedUsers: []*UserObj{},
+				Topics:       []*TopicObj{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CreateSpaceDictionary(tt.args.space, tt.args.includes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateSpaceDictionary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpacesRaw_SpaceDictionaries(t *testing.T) {
+	raw := &SpacesRaw{
+		Spaces: []*SpaceObj{
+			{ID: "1DXxyRYNejbKM", CreatorID: "2244994945"},
+		},
+		Includes: &SpacesRawIncludes{
+			Users: []*UserObj{{ID: "2244994945", UserName: "TwitterDev"}},
+		},
+	}
+	dictionary, has := raw.SpaceDictionaries()["1DXxyRYNejbKM"]
+	if !has || dictionary.Creator == nil || dictionary.Creator.UserName != "TwitterDev" {
+		t.Errorf("SpacesRaw.SpaceDictionaries() = %v", dictionary)
+	}
+}
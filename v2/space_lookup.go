@@ -46,15 +46,67 @@ type spaceRaw struct {
 
 // SpacesRaw the raw space objects
 type SpacesRaw struct {
-	Spaces   []*SpaceObj        `json:"data"`
-	Includes *SpacesRawIncludes `json:"includes,omitempty"`
-	Errors   []*ErrorObj        `json:"errors,omitempty"`
+	Spaces       []*SpaceObj        `json:"data"`
+	Includes     *SpacesRawIncludes `json:"includes,omitempty"`
+	Errors       []*ErrorObj        `json:"errors,omitempty"`
+	dictionaries map[string]*SpaceDictionary
+}
+
+// SpaceDictionaries create a map of space dictionaries from the raw space response
+func (s *SpacesRaw) SpaceDictionaries() map[string]*SpaceDictionary {
+	if s.dictionaries != nil {
+		return s.dictionaries
+	}
+
+	s.dictionaries = map[string]*SpaceDictionary{}
+	for _, space := range s.Spaces {
+		s.dictionaries[space.ID] = CreateSpaceDictionary(*space, s.Includes)
+	}
+	return s.dictionaries
 }
 
 // SpacesRawIncludes are the includes for a space
 type SpacesRawIncludes struct {
-	Users  []*UserObj  `json:"users,omitempty"`
-	Topics []*TopicObj `json:"topics,omitempty"`
+	Users    []*UserObj  `json:"users,omitempty"`
+	Topics   []*TopicObj `json:"topics,omitempty"`
+	userIDs  map[string]*UserObj
+	topicIDs map[string]*TopicObj
+}
+
+// UsersByID will return a map of user ids to object
+func (s *SpacesRawIncludes) UsersByID() map[string]*UserObj {
+	switch {
+	case s.userIDs == nil:
+		return s.usersByID()
+	default:
+		return s.userIDs
+	}
+}
+
+func (s *SpacesRawIncludes) usersByID() map[string]*UserObj {
+	s.userIDs = map[string]*UserObj{}
+	for _, user := range s.Users {
+		s.userIDs[user.ID] = user
+	}
+	return s.userIDs
+}
+
+// TopicsByID will return a map of topic ids to object
+func (s *SpacesRawIncludes) TopicsByID() map[string]*TopicObj {
+	switch {
+	case s.topicIDs == nil:
+		return s.topicsByID()
+	default:
+		return s.topicIDs
+	}
+}
+
+func (s *SpacesRawIncludes) topicsByID() map[string]*TopicObj {
+	s.topicIDs = map[string]*TopicObj{}
+	for _, topic := range s.Topics {
+		s.topicIDs[topic.ID] = topic
+	}
+	return s.topicIDs
 }
 
 // SpacesByCreatorLookupOpts are the options for the space by creator
@@ -139,34 +191,12 @@ type SpaceBuyersLookupResponse struct {
 
 // SpaceTweetsLookupOpts are the options for the space tweets lookup
 type SpaceTweetsLookupOpts struct {
-	Expansions  []Expansion
-	MediaFields []MediaField
-	PlaceFields []PlaceField
-	PollFields  []PollField
-	TweetFields []TweetField
-	UserFields  []UserField
+	TweetSelection
 }
 
 func (s SpaceTweetsLookupOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(s.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(s.Expansions), ","))
-	}
-	if len(s.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(s.MediaFields), ","))
-	}
-	if len(s.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(s.PlaceFields), ","))
-	}
-	if len(s.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(s.PollFields), ","))
-	}
-	if len(s.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(s.TweetFields), ","))
-	}
-	if len(s.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(s.UserFields), ","))
-	}
+	s.TweetSelection.addQuery(q)
 	if len(q) > 0 {
 		req.URL.RawQuery = q.Encode()
 	}
@@ -1,5 +1,7 @@
 package twitter
 
+import "time"
+
 // SpaceField are the space field options
 type SpaceField string
 
@@ -80,3 +82,28 @@ type SpaceObj struct {
 	CreatorID        string   `json:"creator_id"`
 	SubscriberCount  int      `json:"subscriber_count"`
 }
+
+// CreatedAtTime returns the created at as a time
+func (s SpaceObj) CreatedAtTime() (time.Time, error) {
+	return parseTime(s.CreatedAt)
+}
+
+// EndedAtTime returns the ended at as a time
+func (s SpaceObj) EndedAtTime() (time.Time, error) {
+	return parseTime(s.EndedAt)
+}
+
+// ScheduledStartTime returns the scheduled start as a time
+func (s SpaceObj) ScheduledStartTime() (time.Time, error) {
+	return parseTime(s.ScheduledStart)
+}
+
+// StartedAtTime returns the started at as a time
+func (s SpaceObj) StartedAtTime() (time.Time, error) {
+	return parseTime(s.StartedAt)
+}
+
+// UpdatedAtTime returns the updated at as a time
+func (s SpaceObj) UpdatedAtTime() (time.Time, error) {
+	return parseTime(s.UpdatedAt)
+}
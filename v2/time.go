@@ -0,0 +1,40 @@
+package twitter
+
+import (
+	"strconv"
+	"time"
+)
+
+// v1TimeLayouts are the time formats of the v1.1 objects
+var v1TimeLayouts = []string{
+	time.RubyDate,
+	"2006-01-02 15:04:05 -0700",
+	time.RFC3339,
+}
+
+// parseTime will parse the RFC3339 time of the v2 objects.  The time fields are only present when requested,
+// so an empty value will return the zero time.
+func parseTime(value string) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// parseV1Time will parse the time of the v1.1 objects, which is either a formatted time or the milliseconds since epoch
+func parseV1Time(value string) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.UnixMilli(ms).UTC(), nil
+	}
+	var err error
+	for _, layout := range v1TimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
@@ -0,0 +1,124 @@
+package twitter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func Test_parseTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "milliseconds",
+			value: "2021-07-06T18:40:40.000Z",
+			want:  time.Date(2021, time.July, 6, 18, 40, 40, 0, time.UTC),
+		},
+		{
+			name:  "seconds",
+			value: "2022-03-12T20:01:20Z",
+			want:  time.Date(2022, time.March, 12, 20, 1, 20, 0, time.UTC),
+		},
+		{
+			name:  "not present",
+			value: "",
+			want:  time.Time{},
+		},
+		{
+			name:    "not a time",
+			value:   "yesterday",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTime(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseTime() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseV1Time(t *testing.T) {
+	want := time.Date(2018, time.March, 26, 16, 33, 26, 0, time.UTC)
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:  "ruby date",
+			value: "Mon Mar 26 16:33:26 +0000 2018",
+		},
+		{
+			name:  "timestamp",
+			value: "2018-03-26 16:33:26 +0000",
+		},
+		{
+			name:  "milliseconds since epoch",
+			value: "1522082006000",
+		},
+		{
+			name:    "not a time",
+			value:   "yesterday",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseV1Time(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseV1Time() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !got.Equal(want) {
+				t.Errorf("parseV1Time() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSpaceObj_times(t *testing.T) {
+	body := `{
+		"id": "1DXxyRYNejbKM",
+		"state": "scheduled",
+		"created_at": "2021-07-04T23:12:08.000Z",
+		"scheduled_start": "2021-07-14T08:00:12.000Z"
+	}`
+	space := &SpaceObj{}
+	if err := json.Unmarshal([]byte(body), space); err != nil {
+		t.Fatalf("SpaceObj decode error = %v", err)
+	}
+
+	created, err := space.CreatedAtTime()
+	if err != nil || !created.Equal(time.Date(2021, time.July, 4, 23, 12, 8, 0, time.UTC)) {
+		t.Errorf("SpaceObj.CreatedAtTime() = %v, %v", created, err)
+	}
+	start, err := space.ScheduledStartTime()
+	if err != nil || !start.Equal(time.Date(2021, time.July, 14, 8, 0, 12, 0, time.UTC)) {
+		t.Errorf("SpaceObj.ScheduledStartTime() = %v, %v", start, err)
+	}
+	ended, err := space.EndedAtTime()
+	if err != nil || !ended.IsZero() {
+		t.Errorf("SpaceObj.EndedAtTime() = %v, %v", ended, err)
+	}
+
+	// the string fields are not changed, so the object will encode as it was decoded
+	enc, err := json.Marshal(space)
+	if err != nil {
+		t.Fatalf("SpaceObj encode error = %v", err)
+	}
+	decoded := &SpaceObj{}
+	if err := json.Unmarshal(enc, decoded); err != nil || decoded.CreatedAt != "2021-07-04T23:12:08.000Z" {
+		t.Errorf("SpaceObj round trip = %v, %v", decoded.CreatedAt, err)
+	}
+}
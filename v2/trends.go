@@ -0,0 +1,62 @@
+package twitter
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// TrendField can expand the trend information
+type TrendField string
+
+const (
+	// TrendFieldTrendName is the name of the trend
+	TrendFieldTrendName TrendField = "trend_name"
+	// TrendFieldTweetCount is the number of tweets in the trend
+	TrendFieldTweetCount TrendField = "tweet_count"
+)
+
+func trendFieldStringArray(arr []TrendField) []string {
+	strs := make([]string, len(arr))
+	for i, field := range arr {
+		strs[i] = string(field)
+	}
+	return strs
+}
+
+// TrendObj is a trend for the location
+type TrendObj struct {
+	TrendName  string `json:"trend_name"`
+	TweetCount int    `json:"tweet_count"`
+}
+
+// TrendsByWOEIDOpts are the options for the trends of a location
+type TrendsByWOEIDOpts struct {
+	MaxTrends   int
+	TrendFields []TrendField
+}
+
+func (t TrendsByWOEIDOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if t.MaxTrends > 0 {
+		q.Add("max_trends", strconv.Itoa(t.MaxTrends))
+	}
+	if len(t.TrendFields) > 0 {
+		q.Add("trend.fields", strings.Join(trendFieldStringArray(t.TrendFields), ","))
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// TrendsRaw is the raw response from the trends
+type TrendsRaw struct {
+	Trends []*TrendObj `json:"data"`
+	Errors []*ErrorObj `json:"errors,omitempty"`
+}
+
+// TrendsByWOEIDResponse is the response from the trends of a location
+type TrendsByWOEIDResponse struct {
+	Raw       *TrendsRaw
+	RateLimit *RateLimit
+}
@@ -0,0 +1,339 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/g8rswimmer/go-twitter/v2/snowflake"
+)
+
+const tweetConversationMaxResults = 100
+
+// TweetConversationOrder is the order of the replies of each tweet in the conversation
+type TweetConversationOrder string
+
+const (
+	// TweetConversationOrderOldest will order the replies from the oldest to the newest
+	TweetConversationOrderOldest TweetConversationOrder = "oldest"
+	// TweetConversationOrderNewest will order the replies from the newest to the oldest
+	TweetConversationOrderNewest TweetConversationOrder = "newest"
+	// TweetConversationOrderMostReplies will order the replies with the most replies under them first
+	TweetConversationOrderMostReplies TweetConversationOrder = "most_replies"
+)
+
+// TweetConversationGap is the reason that a tweet of the conversation is not present
+type TweetConversationGap string
+
+const (
+	// TweetConversationGapDeleted is a tweet that was not found, which is usually deleted
+	TweetConversationGapDeleted TweetConversationGap = "deleted"
+	// TweetConversationGapHidden is a tweet that is not authorized to be viewed, like a protected or suspended user
+	TweetConversationGapHidden TweetConversationGap = "hidden"
+	// TweetConversationGapMissing is a tweet that was not returned by the search or the lookup
+	TweetConversationGapMissing TweetConversationGap = "missing"
+)
+
+// TweetConversationOpts are the options for building the conversation.
+//
+// FullArchive will use the full archive search instead of the recent search.
+//
+// MaxPages limits the number of search pages, zero will search all of the pages.
+//
+// SkipLookup will not lookup the parents that are not part of the search results, so they will be gaps.
+//
+// The fields and expansions, with the selection, are used for the search and the lookup.  The fields needed to
+// build the conversation are always requested.
+type TweetConversationOpts struct {
+	TweetSelection
+	Order       TweetConversationOrder
+	FullArchive bool
+	MaxResults  int
+	MaxPages    int
+	SkipLookup  bool
+}
+
+func (t TweetConversationOpts) selection() TweetSelection {
+	return TweetSelection{
+		TweetFields: []TweetField{TweetFieldConversationID, TweetFieldReferencedTweets, TweetFieldCreatedAt, TweetFieldAuthorID},
+	}.Merge(t.TweetSelection)
+}
+
+func (t TweetConversationOpts) lookupOpts() TweetLookupOpts {
+	return TweetLookupOpts{
+		TweetSelection: t.selection(),
+	}
+}
+
+// TweetConversationNode is a tweet of the conversation and its replies.  If the tweet is not present, the gap
+// is the reason and the dictionary is nil.  Gaps with an unknown parent are replies of the root.
+type TweetConversationNode struct {
+	ID         string
+	Depth      int
+	Gap        TweetConversationGap
+	Dictionary *TweetDictionary
+	Replies    []*TweetConversationNode
+	Parent     *TweetConversationNode
+}
+
+// Walk will call the function for the node and each of the replies, depth first.  Returning false will not walk
+// the replies of the node.
+func (n *TweetConversationNode) Walk(fn func(node *TweetConversationNode) bool) {
+	if !fn(n) {
+		return
+	}
+	for _, reply := range n.Replies {
+		reply.Walk(fn)
+	}
+}
+
+// Gaps will return the nodes of the conversation that are not present
+func (n *TweetConversationNode) Gaps() []*TweetConversationNode {
+	gaps := []*TweetConversationNode{}
+	n.Walk(func(node *TweetConversationNode) bool {
+		if len(node.Gap) > 0 {
+			gaps = append(gaps, node)
+		}
+		return true
+	})
+	return gaps
+}
+
+// Size returns the number of nodes of the conversation, including the node
+func (n *TweetConversationNode) Size() int {
+	size := 0
+	n.Walk(func(*TweetConversationNode) bool {
+		size++
+		return true
+	})
+	return size
+}
+
+type tweetConversationNodeJSON struct {
+	ID      string                   `json:"id"`
+	Depth   int                      `json:"depth"`
+	Gap     TweetConversationGap     `json:"gap,omitempty"`
+	Tweet   *TweetObj                `json:"tweet,omitempty"`
+	Author  *UserObj                 `json:"author,omitempty"`
+	Replies []*TweetConversationNode `json:"replies,omitempty"`
+}
+
+// MarshalJSON will export the node with the tweet, the author and the replies
+func (n *TweetConversationNode) MarshalJSON() ([]byte, error) {
+	node := tweetConversationNodeJSON{
+		ID:      n.ID,
+		Depth:   n.Depth,
+		Gap:     n.Gap,
+		Replies: n.Replies,
+	}
+	if n.Dictionary != nil {
+		node.Tweet = &n.Dictionary.Tweet
+		node.Author = n.Dictionary.Author
+	}
+	return json.Marshal(node)
+}
+
+// TweetConversationResponse is the conversation tree
+type TweetConversationResponse struct {
+	Root      *TweetConversationNode
+	RateLimit *RateLimit
+}
+
+// tweetConversationBuilder collects the tweets of the conversation and builds the tree
+type tweetConversationBuilder struct {
+	id       string
+	order    TweetConversationOrder
+	tweets   map[string]*TweetObj
+	gaps     map[string]TweetConversationGap
+	includes *TweetRawIncludes
+}
+
+func newTweetConversationBuilder(id string, order TweetConversationOrder) *tweetConversationBuilder {
+	return &tweetConversationBuilder{
+		id:       id,
+		order:    order,
+		tweets:   map[string]*TweetObj{},
+		gaps:     map[string]TweetConversationGap{},
+		includes: &TweetRawIncludes{},
+	}
+}
+
+func (b *tweetConversationBuilder) add(raw *TweetRaw) {
+	if raw == nil {
+		return
+	}
+	for _, tweet := range raw.Tweets {
+		if tweet != nil {
+			b.tweets[tweet.ID] = tweet
+			delete(b.gaps, tweet.ID)
+		}
+	}
+	for _, e := range raw.Errors {
+		id := fmt.Sprint(e.Value)
+		if e.ResourceType != "tweet" || len(id) == 0 {
+			continue
+		}
+		if _, has := b.tweets[id]; has {
+			continue
+		}
+		switch {
+		case strings.HasSuffix(e.Type, "not-authorized-for-resource"):
+			b.gaps[id] = TweetConversationGapHidden
+		case strings.HasSuffix(e.Type, "resource-not-found"):
+			b.gaps[id] = TweetConversationGapDeleted
+		default:
+		}
+	}
+	if raw.Includes != nil {
+		b.includes.Tweets = append(b.includes.Tweets, raw.Includes.Tweets...)
+		b.includes.Users = append(b.includes.Users, raw.Includes.Users...)
+		b.includes.Places = append(b.includes.Places, raw.Includes.Places...)
+		b.includes.Media = append(b.includes.Media, raw.Includes.Media...)
+		b.includes.Polls = append(b.includes.Polls, raw.Includes.Polls...)
+	}
+}
+
+// missing returns the root and the parents that are not tweets or known gaps
+func (b *tweetConversationBuilder) missing(looked map[string]bool) []string {
+	ids := []string{}
+	check := func(id string) {
+		if len(id) == 0 || looked[id] {
+			return
+		}
+		if _, has := b.tweets[id]; has {
+			return
+		}
+		if _, has := b.gaps[id]; has {
+			return
+		}
+		looked[id] = true
+		ids = append(ids, id)
+	}
+	check(b.id)
+	for _, tweet := range b.tweets {
+		check(tweetConversationParentID(tweet))
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (b *tweetConversationBuilder) build() *TweetConversationNode {
+	nodes := map[string]*TweetConversationNode{}
+	dictionaries := NewTweetDictionaryBuilder(b.includes, TweetDictionaryOpts{})
+	node := func(id string) *TweetConversationNode {
+		if n, has := nodes[id]; has {
+			return n
+		}
+		n := &TweetConversationNode{
+			ID:      id,
+			Replies: []*TweetConversationNode{},
+		}
+		switch tweet, has := b.tweets[id]; {
+		case has:
+			n.Dictionary = dictionaries.Build(*tweet)
+		case len(b.gaps[id]) > 0:
+			n.Gap = b.gaps[id]
+		default:
+			n.Gap = TweetConversationGapMissing
+		}
+		nodes[id] = n
+		return n
+	}
+
+	root := node(b.id)
+	ids := make([]string, 0, len(b.tweets)+len(b.gaps))
+	for id := range b.tweets {
+		ids = append(ids, id)
+	}
+	for id := range b.gaps {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		n := node(id)
+		if n == root {
+			continue
+		}
+		parentID := ""
+		if tweet, has := b.tweets[id]; has {
+			parentID = tweetConversationParentID(tweet)
+		}
+		parent := root
+		if len(parentID) > 0 && !b.ancestor(parentID, id) {
+			parent = node(parentID)
+		}
+		_, isTweet := b.tweets[parent.ID]
+		_, isGap := b.gaps[parent.ID]
+		if parent != root && parent.Parent == nil && !isTweet && !isGap {
+			// the parent was not returned, so its parent is unknown
+			parent.Parent = root
+			root.Replies = append(root.Replies, parent)
+		}
+		n.Parent = parent
+		parent.Replies = append(parent.Replies, n)
+	}
+
+	b.sort(root, 0)
+	return root
+}
+
+// ancestor will check if the id is an ancestor of the parent, which would make a cycle
+func (b *tweetConversationBuilder) ancestor(parentID, id string) bool {
+	seen := map[string]bool{}
+	for current := parentID; len(current) > 0 && !seen[current]; {
+		if current == id {
+			return true
+		}
+		seen[current] = true
+		tweet, has := b.tweets[current]
+		if !has {
+			return false
+		}
+		current = tweetConversationParentID(tweet)
+	}
+	return false
+}
+
+func (b *tweetConversationBuilder) sort(n *TweetConversationNode, depth int) int {
+	n.Depth = depth
+	sizes := map[string]int{}
+	size := 1
+	for _, reply := range n.Replies {
+		sizes[reply.ID] = b.sort(reply, depth+1)
+		size += sizes[reply.ID]
+	}
+	sort.SliceStable(n.Replies, func(i, j int) bool {
+		a, c := n.Replies[i], n.Replies[j]
+		switch b.order {
+		case TweetConversationOrderNewest:
+			return tweetConversationCompare(a.ID, c.ID) > 0
+		case TweetConversationOrderMostReplies:
+			if sizes[a.ID] != sizes[c.ID] {
+				return sizes[a.ID] > sizes[c.ID]
+			}
+			return tweetConversationCompare(a.ID, c.ID) < 0
+		default:
+			return tweetConversationCompare(a.ID, c.ID) < 0
+		}
+	})
+	return size
+}
+
+// tweetConversationCompare compares the ids by time, which are snowflakes
+func tweetConversationCompare(a, b string) int {
+	if cmp, err := snowflake.Compare(a, b); err == nil {
+		return cmp
+	}
+	return strings.Compare(a, b)
+}
+
+func tweetConversationParentID(tweet *TweetObj) string {
+	for _, ref := range tweet.ReferencedTweets {
+		if ref != nil && ref.Type == "replied_to" {
+			return ref.ID
+		}
+	}
+	return ""
+}
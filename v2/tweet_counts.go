@@ -1,5 +1,7 @@
 package twitter
 
+import "time"
+
 // TweetRecentCountsResponse contains all of the information from a tweet recent counts
 type TweetRecentCountsResponse struct {
 	TweetCounts []*TweetCount          `json:"data"`
@@ -19,6 +21,16 @@ type TweetCount struct {
 	TweetCount int    `json:"tweet_count"`
 }
 
+// StartTime returns the start as a time
+func (t TweetCount) StartTime() (time.Time, error) {
+	return parseTime(t.Start)
+}
+
+// EndTime returns the end as a time
+func (t TweetCount) EndTime() (time.Time, error) {
+	return parseTime(t.End)
+}
+
 // TweetAllCountsResponse contain all fo the information from a tweet all counts
 type TweetAllCountsResponse struct {
 	TweetCounts []*TweetCount       `json:"data"`
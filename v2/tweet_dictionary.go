@@ -10,6 +10,7 @@ type TweetDictionary struct {
 	AttachmentMedia  []*MediaObj
 	Mentions         []*TweetMention
 	ReferencedTweets []*TweetReference
+	EditHistory      []*TweetObj
 }
 
 // TweetMention is the mention and the user associated with it
@@ -26,6 +27,94 @@ type TweetReference struct {
 
 // CreateTweetDictionary will create a dictionary from a tweet and the includes
 func CreateTweetDictionary(tweet TweetObj, includes *TweetRawIncludes) *TweetDictionary {
+	return NewTweetDictionaryBuilder(includes, TweetDictionaryOpts{}).Build(tweet)
+}
+
+// TweetDictionaryOpts are the options for building the dictionaries.  MaxDepth limits the depth of the referenced
+// tweet dictionaries, where zero is not limited.
+type TweetDictionaryOpts struct {
+	MaxDepth int
+}
+
+type tweetDictionaryKey struct {
+	id        string
+	remaining int
+}
+
+// TweetDictionaryBuilder will build the dictionaries of the tweets from the same includes.  The include indexes are
+// shared and the dictionary of a referenced tweet is built once, so a tweet that is referenced more than once has
+// the same dictionary.  A reference that would make a cycle is not added to the referenced tweets, and a dictionary
+// missing such a reference is not shared with the other tweets.
+type TweetDictionaryBuilder struct {
+	includes     *TweetRawIncludes
+	opts         TweetDictionaryOpts
+	userIDs      map[string]*UserObj
+	userNames    map[string]*UserObj
+	pollIDs      map[string]*PollObj
+	mediaKeys    map[string]*MediaObj
+	placeIDs     map[string]*PlaceObj
+	tweets       map[string]*TweetObj
+	dictionaries map[tweetDictionaryKey]*TweetDictionary
+	building     map[string]bool
+	cuts         int
+}
+
+// NewTweetDictionaryBuilder will create a dictionary builder for the includes
+func NewTweetDictionaryBuilder(includes *TweetRawIncludes, opts TweetDictionaryOpts) *TweetDictionaryBuilder {
+	b := &TweetDictionaryBuilder{
+		includes:     includes,
+		opts:         opts,
+		dictionaries: map[tweetDictionaryKey]*TweetDictionary{},
+		building:     map[string]bool{},
+	}
+	if includes != nil {
+		b.userIDs = includes.UsersByID()
+		b.userNames = includes.UsersByUserName()
+		b.pollIDs = includes.PollsByID()
+		b.mediaKeys = includes.MediaByKeys()
+		b.placeIDs = includes.PlacesByID()
+		b.tweets = includes.TweetsByID()
+	}
+	return b
+}
+
+// Build will create the dictionary of the tweet
+func (b *TweetDictionaryBuilder) Build(tweet TweetObj) *TweetDictionary {
+	b.building[tweet.ID] = true
+	defer delete(b.building, tweet.ID)
+	return b.build(tweet, 0)
+}
+
+// reference will return the memoized dictionary of the referenced tweet, or nil if it would make a cycle.  A
+// dictionary that had a reference cut to break a cycle depends on the tweets being built, so it is not memoized.
+func (b *TweetDictionaryBuilder) reference(tweet *TweetObj, depth int) *TweetDictionary {
+	key := tweetDictionaryKey{
+		id:        tweet.ID,
+		remaining: -1,
+	}
+	if b.opts.MaxDepth > 0 {
+		key.remaining = b.opts.MaxDepth - depth
+	}
+	if dictionary, has := b.dictionaries[key]; has {
+		return dictionary
+	}
+	if b.building[tweet.ID] {
+		b.cuts++
+		return nil
+	}
+
+	cuts := b.cuts
+	b.building[tweet.ID] = true
+	dictionary := b.build(*tweet, depth)
+	delete(b.building, tweet.ID)
+
+	if b.cuts == cuts {
+		b.dictionaries[key] = dictionary
+	}
+	return dictionary
+}
+
+func (b *TweetDictionaryBuilder) build(tweet TweetObj, depth int) *TweetDictionary {
 	dictionary := &TweetDictionary{
 		Tweet:            tweet,
 		AttachmentMedia:  []*MediaObj{},
@@ -33,24 +122,21 @@ func CreateTweetDictionary(tweet TweetObj, includes *TweetRawIncludes) *TweetDic
 		Mentions:         []*TweetMention{},
 		ReferencedTweets: []*TweetReference{},
 	}
-	if includes == nil {
+	if b.includes == nil {
 		return dictionary
 	}
 
-	userIDs := includes.UsersByID()
-	if user, has := userIDs[tweet.AuthorID]; has {
+	if user, has := b.userIDs[tweet.AuthorID]; has {
 		dictionary.Author = user
 	}
-	if user, has := userIDs[tweet.InReplyToUserID]; has {
+	if user, has := b.userIDs[tweet.InReplyToUserID]; has {
 		dictionary.InReplyUser = user
 	}
 
 	if tweet.Entities != nil {
-		userNames := includes.UsersByUserName()
-
 		mentions := []*TweetMention{}
 		for i, entity := range tweet.Entities.Mentions {
-			if user, has := userNames[entity.UserName]; has {
+			if user, has := b.userNames[entity.UserName]; has {
 				mention := &TweetMention{
 					Mention: &tweet.Entities.Mentions[i],
 					User:    user,
@@ -62,46 +148,58 @@ func CreateTweetDictionary(tweet TweetObj, includes *TweetRawIncludes) *TweetDic
 	}
 
 	if tweet.Attachments != nil {
-		pollIDs := includes.PollsByID()
-
 		attachmentPolls := []*PollObj{}
 		for _, id := range tweet.Attachments.PollIDs {
-			if poll, has := pollIDs[id]; has {
+			if poll, has := b.pollIDs[id]; has {
 				attachmentPolls = append(attachmentPolls, poll)
 			}
 		}
 		dictionary.AttachmentPolls = attachmentPolls
 
-		mediaKeys := includes.MediaByKeys()
-
 		attachmentMedia := []*MediaObj{}
 		for _, key := range tweet.Attachments.MediaKeys {
-			if media, has := mediaKeys[key]; has {
+			if media, has := b.mediaKeys[key]; has {
 				attachmentMedia = append(attachmentMedia, media)
 			}
 		}
 		dictionary.AttachmentMedia = attachmentMedia
 	}
 	if tweet.Geo != nil {
-		placeIDs := includes.PlacesByID()
-		if place, has := placeIDs[tweet.Geo.PlaceID]; has {
+		if place, has := b.placeIDs[tweet.Geo.PlaceID]; has {
 			dictionary.Place = place
 		}
 	}
 
-	tweets := includes.TweetsByID()
 	tweetReferences := []*TweetReference{}
-
-	for i, rt := range tweet.ReferencedTweets {
-		if t, has := tweets[rt.ID]; has {
-			ref := &TweetReference{
-				Reference:       tweet.ReferencedTweets[i],
-				TweetDictionary: CreateTweetDictionary(*t, includes),
+	if b.opts.MaxDepth == 0 || depth < b.opts.MaxDepth {
+		for i, rt := range tweet.ReferencedTweets {
+			t, has := b.tweets[rt.ID]
+			if !has {
+				continue
+			}
+			if referenced := b.reference(t, depth+1); referenced != nil {
+				ref := &TweetReference{
+					Reference:       tweet.ReferencedTweets[i],
+					TweetDictionary: referenced,
+				}
+				tweetReferences = append(tweetReferences, ref)
 			}
-			tweetReferences = append(tweetReferences, ref)
 		}
 	}
 	dictionary.ReferencedTweets = tweetReferences
 
+	if len(tweet.EditHistoryTweetIDs) > 0 {
+		editHistory := []*TweetObj{}
+		for _, id := range tweet.EditHistoryTweetIDs {
+			switch t, has := b.tweets[id]; {
+			case id == tweet.ID:
+				editHistory = append(editHistory, &dictionary.Tweet)
+			case has:
+				editHistory = append(editHistory, t)
+			}
+		}
+		dictionary.EditHistory = editHistory
+	}
+
 	return dictionary
 }
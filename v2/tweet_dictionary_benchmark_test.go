@@ -0,0 +1,125 @@
+package twitter
+
+import (
+	"fmt"
+	"testing"
+)
+
+// legacyCreateTweetDictionary is the recursive dictionary creation that was used before the dictionary builder.  It
+// is kept to compare the time and allocations of building the dictionaries.
+func legacyCreateTweetDictionary(tweet TweetObj, includes *TweetRawIncludes) *TweetDictionary {
+	dictionary := &TweetDictionary{
+		Tweet:            tweet,
+		AttachmentMedia:  []*MediaObj{},
+		AttachmentPolls:  []*PollObj{},
+		Mentions:         []*TweetMention{},
+		ReferencedTweets: []*TweetReference{},
+	}
+	if includes == nil {
+		return dictionary
+	}
+
+	userIDs := includes.UsersByID()
+	if user, has := userIDs[tweet.AuthorID]; has {
+		dictionary.Author = user
+	}
+	if user, has := userIDs[tweet.InReplyToUserID]; has {
+		dictionary.InReplyUser = user
+	}
+
+	if tweet.Entities != nil {
+		userNames := includes.UsersByUserName()
+
+		mentions := []*TweetMention{}
+		for i, entity := range tweet.Entities.Mentions {
+			if user, has := userNames[entity.UserName]; has {
+				mentions = append(mentions, &TweetMention{
+					Mention: &tweet.Entities.Mentions[i],
+					User:    user,
+				})
+			}
+		}
+		dictionary.Mentions = mentions
+	}
+
+	tweets := includes.TweetsByID()
+	tweetReferences := []*TweetReference{}
+	for i, rt := range tweet.ReferencedTweets {
+		if t, has := tweets[rt.ID]; has {
+			tweetReferences = append(tweetReferences, &TweetReference{
+				Reference:       tweet.ReferencedTweets[i],
+				TweetDictionary: legacyCreateTweetDictionary(*t, includes),
+			})
+		}
+	}
+	dictionary.ReferencedTweets = tweetReferences
+	return dictionary
+}
+
+// benchmarkSearchPage is a search page of tweets that quote the tweets of chains in the includes, so the same
+// included tweets are referenced many times
+func benchmarkSearchPage(tweets, chains, chainLength int) *TweetRaw {
+	raw := &TweetRaw{
+		Includes: &TweetRawIncludes{},
+	}
+	for u := 0; u < 100; u++ {
+		raw.Includes.Users = append(raw.Includes.Users, &UserObj{
+			ID:       fmt.Sprintf("%d", u),
+			UserName: fmt.Sprintf("user%d", u),
+		})
+	}
+	for c := 0; c < chains; c++ {
+		for l := 0; l < chainLength; l++ {
+			tweet := &TweetObj{
+				ID:       fmt.Sprintf("include-%d-%d", c, l),
+				AuthorID: fmt.Sprintf("%d", (c+l)%100),
+			}
+			if l+1 < chainLength {
+				tweet.ReferencedTweets = []*TweetReferencedTweetObj{{Type: "quoted", ID: fmt.Sprintf("include-%d-%d", c, l+1)}}
+			}
+			raw.Includes.Tweets = append(raw.Includes.Tweets, tweet)
+		}
+	}
+	for i := 0; i < tweets; i++ {
+		raw.Tweets = append(raw.Tweets, &TweetObj{
+			ID:       fmt.Sprintf("%d", i),
+			AuthorID: fmt.Sprintf("%d", i%100),
+			Entities: &EntitiesObj{
+				Mentions: []EntityMentionObj{{UserName: fmt.Sprintf("user%d", (i+1)%100)}},
+			},
+			ReferencedTweets: []*TweetReferencedTweetObj{
+				{Type: "quoted", ID: fmt.Sprintf("include-%d-0", i%chains)},
+				{Type: "replied_to", ID: fmt.Sprintf("include-%d-0", (i+1)%chains)},
+			},
+		})
+	}
+	return raw
+}
+
+func BenchmarkTweetDictionaries(b *testing.B) {
+	for _, page := range []struct {
+		tweets      int
+		chains      int
+		chainLength int
+	}{
+		{tweets: 100, chains: 10, chainLength: 5},
+		{tweets: 500, chains: 20, chainLength: 20},
+	} {
+		raw := benchmarkSearchPage(page.tweets, page.chains, page.chainLength)
+		name := fmt.Sprintf("tweets_%d_chain_%d", page.tweets, page.chainLength)
+		b.Run(name+"/legacy", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, tweet := range raw.Tweets {
+					legacyCreateTweetDictionary(*tweet, raw.Includes)
+				}
+			}
+		})
+		b.Run(name+"/builder", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				raw.TweetDictionariesWithOpts(TweetDictionaryOpts{})
+			}
+		})
+	}
+}
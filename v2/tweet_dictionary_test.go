@@ -348,3 +348,101 @@ func TestCreateTweetDictionary(t *testing.T) {
 		})
 	}
 }
+
+func TestTweetDictionaryBuilder_Build(t *testing.T) {
+	quoted := func(id string) []*TweetReferencedTweetObj {
+		return []*TweetReferencedTweetObj{{Type: "quoted", ID: id}}
+	}
+	includes := &TweetRawIncludes{
+		Tweets: []*TweetObj{
+			{ID: "self", ReferencedTweets: quoted("self")},
+			{ID: "a", ReferencedTweets: quoted("b")},
+			{ID: "b", ReferencedTweets: quoted("a")},
+			{ID: "c", ReferencedTweets: quoted("d")},
+			{ID: "d", ReferencedTweets: quoted("e")},
+			{ID: "e"},
+		},
+	}
+	depth := func(dictionary *TweetDictionary) int {
+		d := 0
+		for len(dictionary.ReferencedTweets) > 0 {
+			dictionary = dictionary.ReferencedTweets[0].TweetDictionary
+			d++
+		}
+		return d
+	}
+	tests := []struct {
+		name      string
+		tweet     TweetObj
+		opts      TweetDictionaryOpts
+		wantDepth int
+	}{
+		{
+			name:      "self reference",
+			tweet:     TweetObj{ID: "self", ReferencedTweets: quoted("self")},
+			wantDepth: 0,
+		},
+		{
+			name:      "cycle",
+			tweet:     TweetObj{ID: "1", ReferencedTweets: quoted("a")},
+			wantDepth: 2,
+		},
+		{
+			name:      "chain",
+			tweet:     TweetObj{ID: "1", ReferencedTweets: quoted("c")},
+			wantDepth: 3,
+		},
+		{
+			name:      "max depth",
+			tweet:     TweetObj{ID: "1", ReferencedTweets: quoted("c")},
+			opts:      TweetDictionaryOpts{MaxDepth: 2},
+			wantDepth: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewTweetDictionaryBuilder(includes, tt.opts).Build(tt.tweet)
+			if d := depth(got); d != tt.wantDepth {
+				t.Errorf("TweetDictionaryBuilder.Build() depth = %v, want %v", d, tt.wantDepth)
+			}
+		})
+	}
+}
+
+func TestTweetRaw_TweetDictionariesWithOpts_shared(t *testing.T) {
+	raw := &TweetRaw{
+		Tweets: []*TweetObj{
+			{ID: "1", ReferencedTweets: []*TweetReferencedTweetObj{{Type: "quoted", ID: "3"}}},
+			{ID: "2", ReferencedTweets: []*TweetReferencedTweetObj{{Type: "replied_to", ID: "3"}}},
+		},
+		Includes: &TweetRawIncludes{
+			Tweets: []*TweetObj{{ID: "3", Text: "referenced twice"}},
+		},
+	}
+	dictionaries := raw.TweetDictionariesWithOpts(TweetDictionaryOpts{})
+	first := dictionaries["1"].ReferencedTweets[0].TweetDictionary
+	second := dictionaries["2"].ReferencedTweets[0].TweetDictionary
+	if first != second {
+		t.Errorf("TweetRaw.TweetDictionariesWithOpts() referenced dictionaries are not shared")
+	}
+}
+
+func TestTweetDictionaryBuilder_Build_cycleNotShared(t *testing.T) {
+	includes := &TweetRawIncludes{
+		Tweets: []*TweetObj{
+			{ID: "1", ReferencedTweets: []*TweetReferencedTweetObj{{Type: "quoted", ID: "2"}}},
+			{ID: "2", ReferencedTweets: []*TweetReferencedTweetObj{{Type: "quoted", ID: "1"}}},
+		},
+	}
+	builder := NewTweetDictionaryBuilder(includes, TweetDictionaryOpts{})
+	first := builder.Build(*includes.Tweets[0])
+	if refs := first.ReferencedTweets[0].TweetDictionary.ReferencedTweets; len(refs) != 0 {
+		t.Fatalf("TweetDictionaryBuilder.Build() cycle references = %v, want 0", len(refs))
+	}
+
+	second := builder.Build(TweetObj{ID: "3", ReferencedTweets: []*TweetReferencedTweetObj{{Type: "quoted", ID: "2"}}})
+	referenced := second.ReferencedTweets[0].TweetDictionary
+	if len(referenced.ReferencedTweets) != 1 || referenced.ReferencedTweets[0].TweetDictionary.Tweet.ID != "1" {
+		t.Errorf("TweetDictionaryBuilder.Build() reused the dictionary cut by another root's cycle")
+	}
+}
@@ -0,0 +1,52 @@
+package twitter
+
+// TweetEditHistory is the edit chain of a tweet, ordered from the original to the latest version
+type TweetEditHistory struct {
+	IDs      []string
+	Latest   *TweetObj
+	Versions []*TweetObj
+}
+
+// resolveTweetEditHistory will find the longest edit chain that contains the id.  Each version of an edited tweet
+// has the ids of the versions up to itself, so the longest chain is from the latest version.  The versions only
+// contain the tweets that are present.
+func resolveTweetEditHistory(id string, tweets map[string]*TweetObj) *TweetEditHistory {
+	ids := []string{}
+	if tweet, has := tweets[id]; has {
+		ids = tweet.EditHistoryTweetIDs
+	}
+	for _, tweet := range tweets {
+		if len(tweet.EditHistoryTweetIDs) <= len(ids) {
+			continue
+		}
+		for _, editID := range tweet.EditHistoryTweetIDs {
+			if editID == id {
+				ids = tweet.EditHistoryTweetIDs
+				break
+			}
+		}
+	}
+	if len(ids) == 0 {
+		tweet, has := tweets[id]
+		if !has {
+			return nil
+		}
+		return &TweetEditHistory{
+			IDs:      []string{id},
+			Latest:   tweet,
+			Versions: []*TweetObj{tweet},
+		}
+	}
+
+	history := &TweetEditHistory{
+		IDs:      ids,
+		Latest:   tweets[ids[len(ids)-1]],
+		Versions: []*TweetObj{},
+	}
+	for _, editID := range ids {
+		if tweet, has := tweets[editID]; has {
+			history.Versions = append(history.Versions, tweet)
+		}
+	}
+	return history
+}
@@ -1,8 +1,34 @@
 package twitter
 
-import "fmt"
+import (
+	"fmt"
+	"unicode/utf8"
 
-// CreateTweetRequest is the details of a tweet to create
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	createTweetTextMaxLength         = tweetTextMaxWeightedLength
+	createTweetLongFormTextMaxLength = 25000
+	createTweetMediaMaxIDs           = 4
+	createTweetPollMinOptions        = 2
+	createTweetPollMaxOptions        = 4
+	createTweetPollOptionMaxLength   = 25
+	createTweetPollMinDuration       = 5
+	createTweetPollMaxDuration       = 10080
+)
+
+// The reply settings of the tweet, which allows everyone to reply if not set
+const (
+	ReplySettingsFollowing      = "following"
+	ReplySettingsMentionedUsers = "mentionedUsers"
+	ReplySettingsSubscribers    = "subscribers"
+	ReplySettingsVerified       = "verified"
+)
+
+// CreateTweetRequest is the details of a tweet to create.  LongForm allows the text to be up to 25,000 characters,
+// which is only available to accounts that can post long form tweets, otherwise the text is limited to 280 characters.
+// The text length is the weighted length, see TweetWeightedLength.
 type CreateTweetRequest struct {
 	DirectMessageDeepLink string            `json:"direct_message_deep_link,omitempty"`
 	ForSuperFollowersOnly bool              `json:"for_super_followers_only,omitempty"`
@@ -13,26 +39,57 @@ type CreateTweetRequest struct {
 	Media                 *CreateTweetMedia `json:"media,omitempty"`
 	Poll                  *CreateTweetPoll  `json:"poll,omitempty"`
 	Reply                 *CreateTweetReply `json:"reply,omitempty"`
+	LongForm              bool              `json:"-"`
 }
 
+// validate returns FieldErrors with all of the invalid fields of the request
 func (t CreateTweetRequest) validate() error {
+	errs := FieldErrors{}
+
+	hasMedia := t.Media != nil && len(t.Media.IDs) > 0
+	hasPoll := t.Poll != nil && t.Poll.present()
+	hasQuote := len(t.QuoteTweetID) > 0
+
 	if t.Media != nil {
-		if err := t.Media.validate(); err != nil {
-			return fmt.Errorf("create tweet error: %w", err)
-		}
+		errs = append(errs, t.Media.fieldErrors()...)
 	}
 	if t.Poll != nil {
-		if err := t.Poll.validate(); err != nil {
-			return fmt.Errorf("create tweet error: %w", err)
-		}
+		errs = append(errs, t.Poll.fieldErrors()...)
 	}
-	if t.Poll != nil {
-		if err := t.Reply.validate(); err != nil {
-			return fmt.Errorf("create tweet error: %w", err)
-		}
+	if t.Reply != nil {
+		errs = append(errs, t.Reply.fieldErrors()...)
 	}
-	if (t.Media == nil || len(t.Media.IDs) == 0) && len(t.Text) == 0 {
-		return fmt.Errorf("create tweet text is required if no media ids %w", ErrParameter)
+
+	switch {
+	case hasPoll && hasMedia:
+		errs = append(errs, &FieldError{Field: "poll", Message: "a poll can not be set with media"})
+	case hasPoll && hasQuote:
+		errs = append(errs, &FieldError{Field: "poll", Message: "a poll can not be set with a quote tweet"})
+	case hasMedia && hasQuote:
+		errs = append(errs, &FieldError{Field: "media", Message: "media can not be set with a quote tweet"})
+	default:
+	}
+
+	switch t.ReplySettings {
+	case "", ReplySettingsFollowing, ReplySettingsMentionedUsers, ReplySettingsSubscribers, ReplySettingsVerified:
+	default:
+		errs = append(errs, &FieldError{Field: "reply_settings", Message: fmt.Sprintf("[%s] is not a reply setting", t.ReplySettings)})
+	}
+
+	maxLength := createTweetTextMaxLength
+	if t.LongForm {
+		maxLength = createTweetLongFormTextMaxLength
+	}
+	switch length := TweetWeightedLength(t.Text); {
+	case !hasMedia && len(t.Text) == 0:
+		errs = append(errs, &FieldError{Field: "text", Message: "text is required if no media ids"})
+	case length > maxLength:
+		errs = append(errs, &FieldError{Field: "text", Message: fmt.Sprintf("length [%d] is more than %d", length, maxLength)})
+	default:
+	}
+
+	if err := errs.err(); err != nil {
+		return fmt.Errorf("create tweet error: %w", err)
 	}
 	return nil
 }
@@ -50,23 +107,56 @@ type CreateTweetMedia struct {
 }
 
 func (m CreateTweetMedia) validate() error {
+	return m.fieldErrors().err()
+}
+
+func (m CreateTweetMedia) fieldErrors() FieldErrors {
+	errs := FieldErrors{}
 	if len(m.TaggedUserIDs) > 0 && len(m.IDs) == 0 {
-		return fmt.Errorf("media ids are required if tagged user ids are present %w", ErrParameter)
+		errs = append(errs, &FieldError{Field: "media.tagged_user_ids", Message: "media ids are required if tagged user ids are present"})
 	}
-	return nil
+	if len(m.IDs) > createTweetMediaMaxIDs {
+		errs = append(errs, &FieldError{Field: "media.media_ids", Message: fmt.Sprintf("[%d] ids is more than %d", len(m.IDs), createTweetMediaMaxIDs)})
+	}
+	return errs
 }
 
-// CreateTweetPoll allows for a poll to be posted as the tweet
+// CreateTweetPoll allows for a poll to be posted as the tweet.  The poll has 2 to 4 options of up to 25 characters
+// and a duration of 5 to 10,080 minutes.
 type CreateTweetPoll struct {
 	DurationMinutes int      `json:"duration_minutes,omitempty"`
 	Options         []string `json:"options,omitempty"`
 }
 
+func (p CreateTweetPoll) present() bool {
+	return len(p.Options) > 0 || p.DurationMinutes != 0
+}
+
 func (p CreateTweetPoll) validate() error {
-	if len(p.Options) > 0 && p.DurationMinutes <= 0 {
-		return fmt.Errorf("poll duration minutes are required with options %w", ErrParameter)
+	return p.fieldErrors().err()
+}
+
+func (p CreateTweetPoll) fieldErrors() FieldErrors {
+	errs := FieldErrors{}
+	if !p.present() {
+		return errs
 	}
-	return nil
+	if len(p.Options) < createTweetPollMinOptions || len(p.Options) > createTweetPollMaxOptions {
+		errs = append(errs, &FieldError{Field: "poll.options", Message: fmt.Sprintf("[%d] options must be between %d and %d", len(p.Options), createTweetPollMinOptions, createTweetPollMaxOptions)})
+	}
+	for i, option := range p.Options {
+		switch length := utf8.RuneCountInString(norm.NFC.String(option)); {
+		case length == 0:
+			errs = append(errs, &FieldError{Field: fmt.Sprintf("poll.options[%d]", i), Message: "the option is empty"})
+		case length > createTweetPollOptionMaxLength:
+			errs = append(errs, &FieldError{Field: fmt.Sprintf("poll.options[%d]", i), Message: fmt.Sprintf("length [%d] is more than %d", length, createTweetPollOptionMaxLength)})
+		default:
+		}
+	}
+	if p.DurationMinutes < createTweetPollMinDuration || p.DurationMinutes > createTweetPollMaxDuration {
+		errs = append(errs, &FieldError{Field: "poll.duration_minutes", Message: fmt.Sprintf("[%d] minutes must be between %d and %d", p.DurationMinutes, createTweetPollMinDuration, createTweetPollMaxDuration)})
+	}
+	return errs
 }
 
 // CreateTweetReply sets the reply setting for the tweet
@@ -76,10 +166,15 @@ type CreateTweetReply struct {
 }
 
 func (r CreateTweetReply) validate() error {
+	return r.fieldErrors().err()
+}
+
+func (r CreateTweetReply) fieldErrors() FieldErrors {
+	errs := FieldErrors{}
 	if len(r.ExcludeReplyUserIDs) > 0 && len(r.InReplyToTweetID) == 0 {
-		return fmt.Errorf("in reply to tweet is needs to be present if excluded reply user ids are present %w", ErrParameter)
+		errs = append(errs, &FieldError{Field: "reply.exclude_reply_user_ids", Message: "in reply to tweet is needs to be present if excluded reply user ids are present"})
 	}
-	return nil
+	return errs
 }
 
 // CreateTweetData is the data returned when creating a tweet
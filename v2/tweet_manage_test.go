@@ -1,6 +1,9 @@
 package twitter
 
 import (
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -75,6 +78,30 @@ func TestCreateTweetPoll_validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "one option",
+			fields: fields{
+				Options:         []string{"yes"},
+				DurationMinutes: 120,
+			},
+			wantErr: true,
+		},
+		{
+			name: "option too long",
+			fields: fields{
+				Options:         []string{"yes", strings.Repeat("n", 26)},
+				DurationMinutes: 120,
+			},
+			wantErr: true,
+		},
+		{
+			name: "duration too long",
+			fields: fields{
+				Options:         []string{"yes", "no"},
+				DurationMinutes: 10081,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -146,6 +173,7 @@ func TestCreateTweetOps_validate(t *testing.T) {
 		Media                 CreateTweetMedia
 		Poll                  CreateTweetPoll
 		Reply                 CreateTweetReply
+		LongForm              bool
 	}
 	tests := []struct {
 		name    string
@@ -183,6 +211,103 @@ func TestCreateTweetOps_validate(t *testing.T) {
 			fields:  fields{},
 			wantErr: true,
 		},
+		{
+			name: "text too long",
+			fields: fields{
+				Text: strings.Repeat("é", 281),
+			},
+			wantErr: true,
+		},
+		{
+			name: "long form",
+			fields: fields{
+				Text:     strings.Repeat("é", 281),
+				LongForm: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "url counted as 23",
+			fields: fields{
+				Text: strings.Repeat("a", 256) + " https://go.dev/" + strings.Repeat("b", 100),
+			},
+			wantErr: false,
+		},
+		{
+			name: "cjk weighted",
+			fields: fields{
+				Text: strings.Repeat("字", 141),
+			},
+			wantErr: true,
+		},
+		{
+			name: "poll",
+			fields: fields{
+				Text: "Which one?",
+				Poll: CreateTweetPoll{
+					Options:         []string{"yes", "no"},
+					DurationMinutes: 5,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "poll and media",
+			fields: fields{
+				Text: "Which one?",
+				Media: CreateTweetMedia{
+					IDs: []string{"12345"},
+				},
+				Poll: CreateTweetPoll{
+					Options:         []string{"yes", "no"},
+					DurationMinutes: 5,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "media and quote",
+			fields: fields{
+				QuoteTweetID: "1455953449422516226",
+				Media: CreateTweetMedia{
+					IDs: []string{"12345"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many media",
+			fields: fields{
+				Media: CreateTweetMedia{
+					IDs: []string{"1", "2", "3", "4", "5"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "reply settings",
+			fields: fields{
+				Text:          "Hello World",
+				ReplySettings: ReplySettingsMentionedUsers,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid reply settings",
+			fields: fields{
+				Text:          "Hello World",
+				ReplySettings: "friends",
+			},
+			wantErr: true,
+		},
+		{
+			name: "long form too long",
+			fields: fields{
+				Text:     strings.Repeat("a", 25001),
+				LongForm: true,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -196,6 +321,7 @@ func TestCreateTweetOps_validate(t *testing.T) {
 				Media:                 &tt.fields.Media,
 				Poll:                  &tt.fields.Poll,
 				Reply:                 &tt.fields.Reply,
+				LongForm:              tt.fields.LongForm,
 			}
 			if err := opts.validate(); (err != nil) != tt.wantErr {
 				t.Errorf("CreateTweetOps.validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -203,3 +329,29 @@ func TestCreateTweetOps_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateTweetRequest_validate_fieldErrors(t *testing.T) {
+	// the reply is not set with a poll
+	req := CreateTweetRequest{
+		Text: strings.Repeat("a", 281),
+		Poll: &CreateTweetPoll{
+			Options:         []string{"yes"},
+			DurationMinutes: 5,
+		},
+	}
+	err := req.validate()
+	if !errors.Is(err, ErrParameter) {
+		t.Fatalf("CreateTweetRequest.validate() error = %v, want %v", err, ErrParameter)
+	}
+	var fieldErrs FieldErrors
+	if !errors.As(err, &fieldErrs) {
+		t.Fatalf("CreateTweetRequest.validate() error = %v, want FieldErrors", err)
+	}
+	fields := []string{}
+	for _, fieldErr := range fieldErrs {
+		fields = append(fields, fieldErr.Field)
+	}
+	if want := []string{"poll.options", "text"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("CreateTweetRequest.validate() fields = %v, want %v", fields, want)
+	}
+}
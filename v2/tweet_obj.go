@@ -1,5 +1,7 @@
 package twitter
 
+import "time"
+
 // TweetField defines the fields of the basic building block of all things twitter
 type TweetField string
 
@@ -42,6 +44,12 @@ const (
 	TweetFieldSource TweetField = "source"
 	// TweetFieldWithHeld contains withholding details
 	TweetFieldWithHeld TweetField = "withheld"
+	// TweetFieldEditHistoryTweetIDs are the unique identifiers of each version of the Tweet, from the original to the most recent.
+	TweetFieldEditHistoryTweetIDs TweetField = "edit_history_tweet_ids"
+	// TweetFieldEditControls indicates how much longer, and how many more times, the Tweet can be edited.
+	TweetFieldEditControls TweetField = "edit_controls"
+	// TweetFieldNoteTweet is the full text and entities of a Tweet that is longer than 280 characters.
+	TweetFieldNoteTweet TweetField = "note_tweet"
 )
 
 func tweetFieldStringArray(arr []TweetField) []string {
@@ -54,25 +62,60 @@ func tweetFieldStringArray(arr []TweetField) []string {
 
 // TweetObj is the primary object on the tweets endpoints
 type TweetObj struct {
-	ID                 string                       `json:"id"`
-	Text               string                       `json:"text"`
-	Attachments        *TweetAttachmentsObj         `json:"attachments,omitempty"`
-	AuthorID           string                       `json:"author_id,omitempty"`
-	ContextAnnotations []*TweetContextAnnotationObj `json:"context_annotations,omitempty"`
-	ConversationID     string                       `json:"conversation_id,omitempty"`
-	CreatedAt          string                       `json:"created_at,omitempty"`
-	Entities           *EntitiesObj                 `json:"entities,omitempty"`
-	Geo                *TweetGeoObj                 `json:"geo,omitempty"`
-	InReplyToUserID    string                       `json:"in_reply_to_user_id,omitempty"`
-	Language           string                       `json:"lang,omitempty"`
-	NonPublicMetrics   *TweetMetricsObj             `json:"non_public_metrics,omitempty"`
-	OrganicMetrics     *TweetMetricsObj             `json:"organic_metrics,omitempty"`
-	PossiblySensitive  bool                         `json:"possibly_sensitive,omitempty"`
-	PromotedMetrics    *TweetMetricsObj             `json:"promoted_metrics,omitempty"`
-	PublicMetrics      *TweetMetricsObj             `json:"public_metrics,omitempty"`
-	ReferencedTweets   []*TweetReferencedTweetObj   `json:"referenced_tweets,omitempty"`
-	Source             string                       `json:"source,omitempty"`
-	WithHeld           *WithHeldObj                 `json:"withheld,omitempty"`
+	ID                  string                       `json:"id"`
+	Text                string                       `json:"text"`
+	Attachments         *TweetAttachmentsObj         `json:"attachments,omitempty"`
+	AuthorID            string                       `json:"author_id,omitempty"`
+	ContextAnnotations  []*TweetContextAnnotationObj `json:"context_annotations,omitempty"`
+	ConversationID      string                       `json:"conversation_id,omitempty"`
+	CreatedAt           string                       `json:"created_at,omitempty"`
+	Entities            *EntitiesObj                 `json:"entities,omitempty"`
+	Geo                 *TweetGeoObj                 `json:"geo,omitempty"`
+	InReplyToUserID     string                       `json:"in_reply_to_user_id,omitempty"`
+	Language            string                       `json:"lang,omitempty"`
+	NonPublicMetrics    *TweetMetricsObj             `json:"non_public_metrics,omitempty"`
+	OrganicMetrics      *TweetMetricsObj             `json:"organic_metrics,omitempty"`
+	PossiblySensitive   bool                         `json:"possibly_sensitive,omitempty"`
+	PromotedMetrics     *TweetMetricsObj             `json:"promoted_metrics,omitempty"`
+	PublicMetrics       *TweetMetricsObj             `json:"public_metrics,omitempty"`
+	ReferencedTweets    []*TweetReferencedTweetObj   `json:"referenced_tweets,omitempty"`
+	Source              string                       `json:"source,omitempty"`
+	WithHeld            *WithHeldObj                 `json:"withheld,omitempty"`
+	EditHistoryTweetIDs []string                     `json:"edit_history_tweet_ids,omitempty"`
+	EditControls        *TweetEditControlsObj        `json:"edit_controls,omitempty"`
+	NoteTweet           *TweetNoteTweetObj           `json:"note_tweet,omitempty"`
+}
+
+// CreatedAtTime returns the created at as a time
+func (t TweetObj) CreatedAtTime() (time.Time, error) {
+	return parseTime(t.CreatedAt)
+}
+
+// FullText returns the text and entities of the tweet.  A long form tweet has the truncated text, so the note
+// tweet text and entities are returned if present.
+func (t TweetObj) FullText() (string, *EntitiesObj) {
+	if t.NoteTweet != nil && len(t.NoteTweet.Text) > 0 {
+		return t.NoteTweet.Text, t.NoteTweet.Entities
+	}
+	return t.Text, t.Entities
+}
+
+// TweetNoteTweetObj is the full text and entities of a long form tweet
+type TweetNoteTweetObj struct {
+	Text     string       `json:"text"`
+	Entities *EntitiesObj `json:"entities,omitempty"`
+}
+
+// TweetEditControlsObj indicates if the tweet can still be edited
+type TweetEditControlsObj struct {
+	EditsRemaining int    `json:"edits_remaining"`
+	IsEditEligible bool   `json:"is_edit_eligible"`
+	EditableUntil  string `json:"editable_until"`
+}
+
+// EditableUntilTime returns the editable until as a time
+func (t TweetEditControlsObj) EditableUntilTime() (time.Time, error) {
+	return parseTime(t.EditableUntil)
 }
 
 // TweetAttachmentsObj specifics the type of attachment present in the tweet
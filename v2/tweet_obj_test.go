@@ -0,0 +1,58 @@
+package twitter
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTweetObj_FullText(t *testing.T) {
+	tests := []struct {
+		name         string
+		tweet        string
+		wantText     string
+		wantEntities *EntitiesObj
+	}{
+		{
+			name:     "tweet",
+			tweet:    `{"id":"1","text":"hello #golang","entities":{"hashtags":[{"start":6,"end":13,"tag":"golang"}]}}`,
+			wantText: "hello #golang",
+			wantEntities: &EntitiesObj{
+				HashTags: []EntityTagObj{
+					{
+						EntityObj: EntityObj{Start: 6, End: 13},
+						Tag:       "golang",
+					},
+				},
+			},
+		},
+		{
+			name:     "note tweet",
+			tweet:    `{"id":"1","text":"hello…","note_tweet":{"text":"hello there #golang","entities":{"hashtags":[{"start":12,"end":19,"tag":"golang"}]}}}`,
+			wantText: "hello there #golang",
+			wantEntities: &EntitiesObj{
+				HashTags: []EntityTagObj{
+					{
+						EntityObj: EntityObj{Start: 12, End: 19},
+						Tag:       "golang",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tweet := TweetObj{}
+			if err := json.Unmarshal([]byte(tt.tweet), &tweet); err != nil {
+				t.Fatalf("TweetObj decode error = %v", err)
+			}
+			text, entities := tweet.FullText()
+			if text != tt.wantText {
+				t.Errorf("TweetObj.FullText() text = %v, want %v", text, tt.wantText)
+			}
+			if !reflect.DeepEqual(entities, tt.wantEntities) {
+				t.Errorf("TweetObj.FullText() entities = %v, want %v", entities, tt.wantEntities)
+			}
+		})
+	}
+}
@@ -1,55 +1,32 @@
 package twitter
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/g8rswimmer/go-twitter/v2/snowflake"
 )
 
 // TweetLookupOpts are the optional paramters that can be passed to the lookup callout
 type TweetLookupOpts struct {
-	Expansions  []Expansion
-	MediaFields []MediaField
-	PlaceFields []PlaceField
-	PollFields  []PollField
-	TweetFields []TweetField
-	UserFields  []UserField
+	TweetSelection
 }
 
 func (t TweetLookupOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(t.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(t.Expansions), ","))
-	}
-	if len(t.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(t.MediaFields), ","))
-	}
-	if len(t.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(t.PlaceFields), ","))
-	}
-	if len(t.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(t.PollFields), ","))
-	}
-	if len(t.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(t.TweetFields), ","))
-	}
-	if len(t.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(t.UserFields), ","))
-	}
+	t.TweetSelection.addQuery(q)
 	if len(q) > 0 {
 		req.URL.RawQuery = q.Encode()
 	}
 }
 
-// UserTweetTimelineOpts are the options for the user tweet timeline request
+// UserTweetTimelineOpts are the options for the user tweet timeline request.  SinceIDTime and UntilIDTime are
+// converted to the since and until ids when the ids are not set.
 type UserTweetTimelineOpts struct {
-	Expansions      []Expansion
-	MediaFields     []MediaField
-	PlaceFields     []PlaceField
-	PollFields      []PollField
-	TweetFields     []TweetField
-	UserFields      []UserField
+	TweetSelection
 	Excludes        []Exclude
 	StartTime       time.Time
 	EndTime         time.Time
@@ -57,28 +34,13 @@ type UserTweetTimelineOpts struct {
 	PaginationToken string
 	SinceID         string
 	UntilID         string
+	SinceIDTime     time.Time
+	UntilIDTime     time.Time
 }
 
 func (t UserTweetTimelineOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(t.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(t.Expansions), ","))
-	}
-	if len(t.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(t.MediaFields), ","))
-	}
-	if len(t.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(t.PlaceFields), ","))
-	}
-	if len(t.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(t.PollFields), ","))
-	}
-	if len(t.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(t.TweetFields), ","))
-	}
-	if len(t.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(t.UserFields), ","))
-	}
+	t.TweetSelection.addQuery(q)
 	if len(t.Excludes) > 0 {
 		q.Add("exclude", strings.Join(excludeStringArray(t.Excludes), ","))
 	}
@@ -94,53 +56,34 @@ func (t UserTweetTimelineOpts) addQuery(req *http.Request) {
 	if len(t.PaginationToken) > 0 {
 		q.Add("pagination_token", t.PaginationToken)
 	}
-	if len(t.SinceID) > 0 {
-		q.Add("since_id", t.SinceID)
+	if sinceID := sinceIDParam(t.SinceID, t.SinceIDTime); len(sinceID) > 0 {
+		q.Add("since_id", sinceID)
 	}
-	if len(t.UntilID) > 0 {
-		q.Add("until_id", t.UntilID)
+	if untilID := untilIDParam(t.UntilID, t.UntilIDTime); len(untilID) > 0 {
+		q.Add("until_id", untilID)
 	}
 	if len(q) > 0 {
 		req.URL.RawQuery = q.Encode()
 	}
 }
 
-// UserMentionTimelineOpts are the options for the user mention timeline request
+// UserMentionTimelineOpts are the options for the user mention timeline request.  SinceIDTime and UntilIDTime are
+// converted to the since and until ids when the ids are not set.
 type UserMentionTimelineOpts struct {
-	Expansions      []Expansion
-	MediaFields     []MediaField
-	PlaceFields     []PlaceField
-	PollFields      []PollField
-	TweetFields     []TweetField
-	UserFields      []UserField
+	TweetSelection
 	StartTime       time.Time
 	EndTime         time.Time
 	MaxResults      int
 	PaginationToken string
 	SinceID         string
 	UntilID         string
+	SinceIDTime     time.Time
+	UntilIDTime     time.Time
 }
 
 func (t UserMentionTimelineOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(t.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(t.Expansions), ","))
-	}
-	if len(t.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(t.MediaFields), ","))
-	}
-	if len(t.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(t.PlaceFields), ","))
-	}
-	if len(t.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(t.PollFields), ","))
-	}
-	if len(t.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(t.TweetFields), ","))
-	}
-	if len(t.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(t.UserFields), ","))
-	}
+	t.TweetSelection.addQuery(q)
 	if !t.StartTime.IsZero() {
 		q.Add("start_time", t.StartTime.Format(time.RFC3339))
 	}
@@ -153,11 +96,11 @@ func (t UserMentionTimelineOpts) addQuery(req *http.Request) {
 	if len(t.PaginationToken) > 0 {
 		q.Add("pagination_token", t.PaginationToken)
 	}
-	if len(t.SinceID) > 0 {
-		q.Add("since_id", t.SinceID)
+	if sinceID := sinceIDParam(t.SinceID, t.SinceIDTime); len(sinceID) > 0 {
+		q.Add("since_id", sinceID)
 	}
-	if len(t.UntilID) > 0 {
-		q.Add("until_id", t.UntilID)
+	if untilID := untilIDParam(t.UntilID, t.UntilIDTime); len(untilID) > 0 {
+		q.Add("until_id", untilID)
 	}
 	if len(q) > 0 {
 		req.URL.RawQuery = q.Encode()
@@ -166,12 +109,7 @@ func (t UserMentionTimelineOpts) addQuery(req *http.Request) {
 
 // UserTweetReverseChronologicalTimelineOpts are the options for the user tweet reverse chronological timeline
 type UserTweetReverseChronologicalTimelineOpts struct {
-	Expansions      []Expansion
-	MediaFields     []MediaField
-	PlaceFields     []PlaceField
-	PollFields      []PollField
-	TweetFields     []TweetField
-	UserFields      []UserField
+	TweetSelection
 	Excludes        []Exclude
 	StartTime       time.Time
 	EndTime         time.Time
@@ -183,24 +121,7 @@ type UserTweetReverseChronologicalTimelineOpts struct {
 
 func (t UserTweetReverseChronologicalTimelineOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(t.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(t.Expansions), ","))
-	}
-	if len(t.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(t.MediaFields), ","))
-	}
-	if len(t.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(t.PlaceFields), ","))
-	}
-	if len(t.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(t.PollFields), ","))
-	}
-	if len(t.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(t.TweetFields), ","))
-	}
-	if len(t.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(t.UserFields), ","))
-	}
+	t.TweetSelection.addQuery(q)
 	if len(t.Excludes) > 0 {
 		q.Add("exclude", strings.Join(excludeStringArray(t.Excludes), ","))
 	}
@@ -226,3 +147,43 @@ func (t UserTweetReverseChronologicalTimelineOpts) addQuery(req *http.Request) {
 		req.URL.RawQuery = q.Encode()
 	}
 }
+
+// validateIDTimes will check that the since and until times can be converted to ids
+func validateIDTimes(sinceID string, sinceTime time.Time, untilID string, untilTime time.Time) error {
+	switch {
+	case len(sinceID) > 0 && !sinceTime.IsZero():
+		return fmt.Errorf("the since id and since id time can not both be set: %w", ErrParameter)
+	case len(untilID) > 0 && !untilTime.IsZero():
+		return fmt.Errorf("the until id and until id time can not both be set: %w", ErrParameter)
+	default:
+	}
+	for _, t := range []time.Time{sinceTime, untilTime} {
+		if t.IsZero() {
+			continue
+		}
+		if _, err := snowflake.FromTime(t); err != nil {
+			return fmt.Errorf("%s: %w", err.Error(), ErrParameter)
+		}
+	}
+	return nil
+}
+
+// sinceIDParam will return the since id, or the since id from the time if the id is not set
+func sinceIDParam(id string, t time.Time) string {
+	if len(id) > 0 || t.IsZero() {
+		return id
+	}
+	// the time is validated before the callout
+	sinceID, _ := snowflake.SinceID(t)
+	return sinceID
+}
+
+// untilIDParam will return the until id, or the until id from the time if the id is not set
+func untilIDParam(id string, t time.Time) string {
+	if len(id) > 0 || t.IsZero() {
+		return id
+	}
+	// the time is validated before the callout
+	untilID, _ := snowflake.UntilID(t)
+	return untilID
+}
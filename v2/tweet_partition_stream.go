@@ -0,0 +1,312 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// TweetPartitionStreamType is the partitioned tweet stream
+type TweetPartitionStreamType string
+
+const (
+	// TweetPartitionStreamFirehose is the full firehose stream
+	TweetPartitionStreamFirehose TweetPartitionStreamType = "firehose"
+	// TweetPartitionStreamSample10 is the 10% sample stream
+	TweetPartitionStreamSample10 TweetPartitionStreamType = "sample10"
+
+	tweetFirehoseStreamMaxPartition = 20
+	tweetSample10StreamMaxPartition = 2
+
+	partitionStreamMinBackoff  = time.Second
+	partitionStreamMaxBackoff  = 5 * time.Minute
+	partitionStreamHealthCheck = 5 * time.Second
+)
+
+func (t TweetPartitionStreamType) maxPartition() int {
+	switch t {
+	case TweetPartitionStreamFirehose:
+		return tweetFirehoseStreamMaxPartition
+	case TweetPartitionStreamSample10:
+		return tweetSample10StreamMaxPartition
+	default:
+		return 0
+	}
+}
+
+// TweetPartitionStreamOpts are the options for the partitioned firehose and sample10 streams.  The partition is required.
+type TweetPartitionStreamOpts struct {
+	Partition       int
+	BackfillMinutes int
+	StartTime       time.Time
+	EndTime         time.Time
+	TweetSelection
+	Deduplicator *TweetDeduplicator
+}
+
+func (t TweetPartitionStreamOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	q.Add("partition", strconv.Itoa(t.Partition))
+	t.TweetSelection.addQuery(q)
+	if t.BackfillMinutes > 0 {
+		q.Add("backfill_minutes", strconv.Itoa(t.BackfillMinutes))
+	}
+	if !t.StartTime.IsZero() {
+		q.Add("start_time", t.StartTime.Format(time.RFC3339))
+	}
+	if !t.EndTime.IsZero() {
+		q.Add("end_time", t.EndTime.Format(time.RFC3339))
+	}
+	req.URL.RawQuery = q.Encode()
+}
+
+// TweetPartitionedStreamOpts are the options to stream multiple partitions as one stream.
+//
+// Partitions are the partitions to connect to, if empty all of the partitions of the stream are used.
+//
+// StreamOpts are used for each partition connection, the partition is set for each connection.
+//
+// ReconnectBackfillMinutes are the backfill minutes used when a partition reconnects.
+//
+// MinBackoff and MaxBackoff are the bounds of the exponential backoff between reconnects.
+type TweetPartitionedStreamOpts struct {
+	Partitions               []int
+	StreamOpts               TweetPartitionStreamOpts
+	ReconnectBackfillMinutes int
+	MinBackoff               time.Duration
+	MaxBackoff               time.Duration
+}
+
+// PartitionHealth is the health of a partition connection
+type PartitionHealth struct {
+	Partition   int
+	Connected   bool
+	Reconnects  int
+	LastMessage time.Time
+	LastError   error
+}
+
+// PartitionStreamError is an error from a partition of the stream
+type PartitionStreamError struct {
+	Partition int
+	Err       error
+}
+
+func (e *PartitionStreamError) Error() string {
+	return fmt.Sprintf("partition %d: %v", e.Partition, e.Err)
+}
+
+// Unwrap will return the wrapped error
+func (e *PartitionStreamError) Unwrap() error {
+	return e.Err
+}
+
+type partitionStreamConnect func(ctx context.Context, opts TweetPartitionStreamOpts) (*TweetStream, error)
+
+// TweetPartitionedStream merges the partitions of a stream into one stream.  Each partition is reconnected
+// when the connection is lost, the keep alive times out or twitter sends a disconnection.
+type TweetPartitionedStream struct {
+	tweets        chan *TweetMessage
+	system        chan map[SystemMessageType]SystemMessage
+	disconnection chan *DisconnectionError
+	err           chan error
+	health        map[int]*PartitionHealth
+	mutex         sync.RWMutex
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+	closeOnce     sync.Once
+}
+
+func startTweetPartitionedStream(ctx context.Context, connect partitionStreamConnect, opts TweetPartitionedStreamOpts) *TweetPartitionedStream {
+	ctx, cancel := context.WithCancel(ctx)
+	ps := &TweetPartitionedStream{
+		tweets:        make(chan *TweetMessage, 10),
+		system:        make(chan map[SystemMessageType]SystemMessage, 10),
+		disconnection: make(chan *DisconnectionError, 10),
+		err:           make(chan error, 10),
+		health:        map[int]*PartitionHealth{},
+		cancel:        cancel,
+	}
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = partitionStreamMinBackoff
+	}
+	if opts.MaxBackoff < opts.MinBackoff {
+		opts.MaxBackoff = partitionStreamMaxBackoff
+	}
+	for _, partition := range opts.Partitions {
+		ps.health[partition] = &PartitionHealth{
+			Partition: partition,
+		}
+	}
+	for _, partition := range opts.Partitions {
+		ps.wg.Add(1)
+		go ps.handlePartition(ctx, connect, partition, opts)
+	}
+	return ps
+}
+
+func (ps *TweetPartitionedStream) handlePartition(ctx context.Context, connect partitionStreamConnect, partition int, opts TweetPartitionedStreamOpts) {
+	defer ps.wg.Done()
+
+	streamOpts := opts.StreamOpts
+	streamOpts.Partition = partition
+	backoff := opts.MinBackoff
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			streamOpts.BackfillMinutes = opts.ReconnectBackfillMinutes
+			ps.updateHealth(partition, func(h *PartitionHealth) {
+				h.Reconnects++
+			})
+		}
+
+		connCtx, connCancel := context.WithCancel(ctx)
+		stream, err := connect(connCtx, streamOpts)
+		if err != nil {
+			connCancel()
+			ps.updateHealth(partition, func(h *PartitionHealth) {
+				h.Connected = false
+				h.LastError = err
+			})
+			ps.sendErr(ctx, &PartitionStreamError{Partition: partition, Err: err})
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > opts.MaxBackoff {
+				backoff = opts.MaxBackoff
+			}
+			continue
+		}
+		backoff = opts.MinBackoff
+		ps.updateHealth(partition, func(h *PartitionHealth) {
+			h.Connected = true
+		})
+
+		ps.forward(ctx, partition, stream)
+
+		// canceling the connection will unblock any reads so the stream can be closed
+		connCancel()
+		stream.Close()
+		ps.updateHealth(partition, func(h *PartitionHealth) {
+			h.Connected = false
+		})
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// forward will send the partition messages to the merged stream until the partition needs to reconnect or is closed
+func (ps *TweetPartitionedStream) forward(ctx context.Context, partition int, stream *TweetStream) {
+	ticker := time.NewTicker(partitionStreamHealthCheck)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !stream.Connection() {
+				ps.sendErr(ctx, &PartitionStreamError{
+					Partition: partition,
+					Err: &StreamError{
+						Type: DisconnectErrorType,
+						Msg:  "keep alive timeout",
+					},
+				})
+				return
+			}
+		case msg := <-stream.Tweets():
+			ps.updateHealth(partition, func(h *PartitionHealth) {
+				h.LastMessage = time.Now()
+			})
+			select {
+			case ps.tweets <- msg:
+			case <-ctx.Done():
+				return
+			}
+		case msg := <-stream.SystemMessages():
+			select {
+			case ps.system <- msg:
+			case <-ctx.Done():
+				return
+			}
+		case msg := <-stream.DisconnectionError():
+			select {
+			case ps.disconnection <- msg:
+			case <-ctx.Done():
+			}
+			return
+		case err := <-stream.Err():
+			ps.updateHealth(partition, func(h *PartitionHealth) {
+				h.LastError = err
+			})
+			ps.sendErr(ctx, &PartitionStreamError{Partition: partition, Err: err})
+		}
+	}
+}
+
+func (ps *TweetPartitionedStream) sendErr(ctx context.Context, err error) {
+	select {
+	case ps.err <- err:
+	case <-ctx.Done():
+	default:
+	}
+}
+
+func (ps *TweetPartitionedStream) updateHealth(partition int, update func(*PartitionHealth)) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	update(ps.health[partition])
+}
+
+// Health returns the health of each partition, ordered by partition
+func (ps *TweetPartitionedStream) Health() []PartitionHealth {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+	health := make([]PartitionHealth, 0, len(ps.health))
+	for _, h := range ps.health {
+		health = append(health, *h)
+	}
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].Partition < health[j].Partition
+	})
+	return health
+}
+
+// Tweets will return the channel to receive tweet stream messages from all partitions
+func (ps *TweetPartitionedStream) Tweets() <-chan *TweetMessage {
+	return ps.tweets
+}
+
+// SystemMessages will return the channel to receive system stream messages from all partitions
+func (ps *TweetPartitionedStream) SystemMessages() <-chan map[SystemMessageType]SystemMessage {
+	return ps.system
+}
+
+// DisconnectionError will return the channel to receive disconnect error messages from all partitions
+func (ps *TweetPartitionedStream) DisconnectionError() <-chan *DisconnectionError {
+	return ps.disconnection
+}
+
+// Err will return the channel to receive any partition errors
+func (ps *TweetPartitionedStream) Err() <-chan error {
+	return ps.err
+}
+
+// Close will close all of the partitions and channels
+func (ps *TweetPartitionedStream) Close() {
+	ps.closeOnce.Do(func() {
+		ps.cancel()
+		ps.wg.Wait()
+		close(ps.tweets)
+		close(ps.system)
+		close(ps.disconnection)
+		close(ps.err)
+	})
+}
@@ -76,11 +76,34 @@ func (t *TweetRaw) TweetDictionaries() map[string]*TweetDictionary {
 		return t.dictionaries
 	}
 
-	t.dictionaries = map[string]*TweetDictionary{}
+	t.dictionaries = t.TweetDictionariesWithOpts(TweetDictionaryOpts{})
+	return t.dictionaries
+}
+
+// TweetDictionariesWithOpts create a map of tweet dictionaries from the raw tweet response, the dictionaries
+// share the referenced tweet dictionaries
+func (t *TweetRaw) TweetDictionariesWithOpts(opts TweetDictionaryOpts) map[string]*TweetDictionary {
+	builder := NewTweetDictionaryBuilder(t.Includes, opts)
+	dictionaries := map[string]*TweetDictionary{}
 	for _, tweet := range t.Tweets {
-		t.dictionaries[tweet.ID] = CreateTweetDictionary(*tweet, t.Includes)
+		dictionaries[tweet.ID] = builder.Build(*tweet)
 	}
-	return t.dictionaries
+	return dictionaries
+}
+
+// EditHistory will resolve the edit chain of any version of a tweet from the tweets and the included tweets.  If
+// the latest version is not in the response, then latest will be nil.
+func (t *TweetRaw) EditHistory(id string) *TweetEditHistory {
+	tweets := map[string]*TweetObj{}
+	if t.Includes != nil {
+		for id, tweet := range t.Includes.TweetsByID() {
+			tweets[id] = tweet
+		}
+	}
+	for _, tweet := range t.Tweets {
+		tweets[tweet.ID] = tweet
+	}
+	return resolveTweetEditHistory(id, tweets)
 }
 
 // TweetRawIncludes contains any additional information from the tweet callout
@@ -105,3 +105,92 @@ func TestTweetRaw_TweetDictionaries(t *testing.T) {
 		})
 	}
 }
+
+func TestTweetRaw_EditHistory(t *testing.T) {
+	raw := &TweetRaw{
+		Tweets: []*TweetObj{
+			{
+				ID:                  "2",
+				Text:                "second",
+				EditHistoryTweetIDs: []string{"1", "2"},
+			},
+			{
+				ID:                  "10",
+				Text:                "not edited",
+				EditHistoryTweetIDs: []string{"10"},
+			},
+		},
+		Includes: &TweetRawIncludes{
+			Tweets: []*TweetObj{
+				{
+					ID:                  "1",
+					Text:                "first",
+					EditHistoryTweetIDs: []string{"1"},
+				},
+				{
+					ID:                  "3",
+					Text:                "third",
+					EditHistoryTweetIDs: []string{"1", "2", "3"},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		id           string
+		wantIDs      []string
+		wantLatest   string
+		wantVersions []string
+		wantNil      bool
+	}{
+		{
+			name:         "original",
+			id:           "1",
+			wantIDs:      []string{"1", "2", "3"},
+			wantLatest:   "3",
+			wantVersions: []string{"first", "second", "third"},
+		},
+		{
+			name:         "middle version",
+			id:           "2",
+			wantIDs:      []string{"1", "2", "3"},
+			wantLatest:   "3",
+			wantVersions: []string{"first", "second", "third"},
+		},
+		{
+			name:         "not edited",
+			id:           "10",
+			wantIDs:      []string{"10"},
+			wantLatest:   "10",
+			wantVersions: []string{"not edited"},
+		},
+		{
+			name:    "unknown",
+			id:      "20",
+			wantNil: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := raw.EditHistory(tt.id)
+			if tt.wantNil {
+				if got != nil {
+					t.Errorf("TweetRaw.EditHistory() = %v, want nil", got)
+				}
+				return
+			}
+			versions := []string{}
+			for _, version := range got.Versions {
+				versions = append(versions, version.Text)
+			}
+			if !reflect.DeepEqual(got.IDs, tt.wantIDs) || got.Latest.ID != tt.wantLatest || !reflect.DeepEqual(versions, tt.wantVersions) {
+				t.Errorf("TweetRaw.EditHistory() = %v %s %v, want %v %s %v", got.IDs, got.Latest.ID, versions, tt.wantIDs, tt.wantLatest, tt.wantVersions)
+			}
+		})
+	}
+
+	dictionary := raw.TweetDictionaries()["2"]
+	if len(dictionary.EditHistory) != 2 || dictionary.EditHistory[0].ID != "1" || dictionary.EditHistory[1].ID != "2" {
+		t.Errorf("TweetRaw.TweetDictionaries() edit history = %v", dictionary.EditHistory)
+	}
+}
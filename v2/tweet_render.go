@@ -0,0 +1,257 @@
+package twitter
+
+import (
+	"html"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// TweetLinkKind is the kind of entity that is being linked
+type TweetLinkKind string
+
+const (
+	// TweetLinkMention is an user mention
+	TweetLinkMention TweetLinkKind = "mention"
+	// TweetLinkHashTag is a hashtag
+	TweetLinkHashTag TweetLinkKind = "hashtag"
+	// TweetLinkCashTag is a cashtag
+	TweetLinkCashTag TweetLinkKind = "cashtag"
+	// TweetLinkURL is an url
+	TweetLinkURL TweetLinkKind = "url"
+)
+
+// TweetLink is an entity of the tweet text that can be rendered as a link
+type TweetLink struct {
+	Kind TweetLinkKind
+	// Text is the text that is displayed for the link, which is the display url for urls
+	Text string
+	// Value is the user name, tag or expanded url of the entity
+	Value string
+	// User is the mentioned user, if it is part of the dictionary
+	User *UserObj
+	// URL is the url entity for the url links
+	URL *EntityURLObj
+}
+
+// TweetLinkFunc will return the href of the link.  If the href is empty, the link will be rendered as text.  The
+// href is used as is, so the function should only return safe urls.
+type TweetLinkFunc func(link TweetLink) string
+
+// DefaultTweetLink will link to the twitter user, hashtag and cashtag pages and to the expanded urls.  Only http
+// and https urls are linked, other urls are rendered as text.
+func DefaultTweetLink(link TweetLink) string {
+	switch link.Kind {
+	case TweetLinkMention:
+		return "https://twitter.com/" + url.PathEscape(link.Value)
+	case TweetLinkHashTag:
+		return "https://twitter.com/hashtag/" + url.PathEscape(link.Value)
+	case TweetLinkCashTag:
+		return "https://twitter.com/search?q=" + url.QueryEscape("$"+link.Value)
+	case TweetLinkURL:
+		u, err := url.Parse(link.Value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return ""
+		}
+		return link.Value
+	default:
+		return ""
+	}
+}
+
+// TweetRenderOpts are the options for rendering the tweet text
+type TweetRenderOpts struct {
+	// LinkFunc will create the links, DefaultTweetLink is used if not set
+	LinkFunc TweetLinkFunc
+	// KeepMediaURLs will keep the media urls in the text, which are removed by default
+	KeepMediaURLs bool
+}
+
+// RenderHTML will render the tweet text as HTML with the entities as links.  The text is escaped.
+func (t *TweetDictionary) RenderHTML(opts TweetRenderOpts) string {
+	return renderTweet(t, opts, html.EscapeString, func(text, href string) string {
+		return `<a href="` + html.EscapeString(href) + `">` + html.EscapeString(text) + `</a>`
+	})
+}
+
+// RenderMarkdown will render the tweet text as markdown with the entities as links.  The text is escaped.
+func (t *TweetDictionary) RenderMarkdown(opts TweetRenderOpts) string {
+	return renderTweet(t, opts, escapeMarkdown, func(text, href string) string {
+		return "[" + escapeMarkdown(text) + "](" + markdownURLEscaper.Replace(href) + ")"
+	})
+}
+
+// tweetTextUnescaper reverses the escaping of the tweet text, which is only done for these characters
+var tweetTextUnescaper = strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">")
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`, "~", `\~`, "|", `\|`, "#", `\#`,
+)
+
+var markdownURLEscaper = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29")
+
+func escapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+type tweetRenderEntity struct {
+	start int
+	end   int
+	// match is the text of the entity without the leading @, # or $
+	match  string
+	prefix []rune
+	link   TweetLink
+	strip  bool
+}
+
+func renderTweet(dictionary *TweetDictionary, opts TweetRenderOpts, escape func(string) string, link func(text, href string) string) string {
+	if dictionary == nil {
+		return ""
+	}
+	linkFunc := opts.LinkFunc
+	if linkFunc == nil {
+		linkFunc = DefaultTweetLink
+	}
+
+	text := []rune(tweetTextUnescaper.Replace(dictionary.Tweet.Text))
+	entities := tweetRenderEntities(dictionary, opts)
+
+	sb := strings.Builder{}
+	cursor := 0
+	stripped := false
+	for _, entity := range entities {
+		start, end, ok := locateTweetEntity(text, cursor, entity)
+		if !ok {
+			continue
+		}
+		sb.WriteString(escape(string(text[cursor:start])))
+		cursor = end
+
+		if entity.strip {
+			stripped = true
+			continue
+		}
+		entity.link.Text = string(text[start:end])
+		if entity.link.Kind == TweetLinkURL && len(entity.link.URL.DisplayURL) > 0 {
+			entity.link.Text = entity.link.URL.DisplayURL
+		}
+		switch href := linkFunc(entity.link); {
+		case len(href) == 0:
+			sb.WriteString(escape(entity.link.Text))
+		default:
+			sb.WriteString(link(entity.link.Text, href))
+		}
+	}
+	sb.WriteString(escape(string(text[cursor:])))
+
+	if stripped {
+		return strings.TrimRightFunc(sb.String(), unicode.IsSpace)
+	}
+	return sb.String()
+}
+
+func tweetRenderEntities(dictionary *TweetDictionary, opts TweetRenderOpts) []tweetRenderEntity {
+	tweetEntities := dictionary.Tweet.Entities
+	if tweetEntities == nil {
+		return nil
+	}
+
+	users := map[string]*UserObj{}
+	for _, mention := range dictionary.Mentions {
+		if mention.User != nil {
+			users[strings.ToLower(mention.User.UserName)] = mention.User
+		}
+	}
+
+	entities := []tweetRenderEntity{}
+	for _, mention := range tweetEntities.Mentions {
+		entities = append(entities, tweetRenderEntity{
+			start:  mention.Start,
+			end:    mention.End,
+			match:  mention.UserName,
+			prefix: []rune{'@', '＠'},
+			link: TweetLink{
+				Kind:  TweetLinkMention,
+				Value: mention.UserName,
+				User:  users[strings.ToLower(mention.UserName)],
+			},
+		})
+	}
+	for _, tag := range tweetEntities.HashTags {
+		entities = append(entities, tweetRenderEntity{
+			start:  tag.Start,
+			end:    tag.End,
+			match:  tag.Tag,
+			prefix: []rune{'#', '＃'},
+			link: TweetLink{
+				Kind:  TweetLinkHashTag,
+				Value: tag.Tag,
+			},
+		})
+	}
+	for _, tag := range tweetEntities.CashTags {
+		entities = append(entities, tweetRenderEntity{
+			start:  tag.Start,
+			end:    tag.End,
+			match:  tag.Tag,
+			prefix: []rune{'$', '＄'},
+			link: TweetLink{
+				Kind:  TweetLinkCashTag,
+				Value: tag.Tag,
+			},
+		})
+	}
+	for i := range tweetEntities.URLs {
+		entityURL := &tweetEntities.URLs[i]
+		value := entityURL.ExpandedURL
+		if len(value) == 0 {
+			value = entityURL.URL
+		}
+		entities = append(entities, tweetRenderEntity{
+			start: entityURL.Start,
+			end:   entityURL.End,
+			match: entityURL.URL,
+			link: TweetLink{
+				Kind:  TweetLinkURL,
+				Value: value,
+				URL:   entityURL,
+			},
+			strip: !opts.KeepMediaURLs && len(entityURL.MediaKey) > 0,
+		})
+	}
+	sort.SliceStable(entities, func(i, j int) bool {
+		return entities[i].start < entities[j].start
+	})
+	return entities
+}
+
+// locateTweetEntity will return the position of the entity in the text.  The offsets are used if they match the
+// entity, otherwise the entity is searched for after the cursor since the offsets are not always reliable.
+func locateTweetEntity(text []rune, cursor int, entity tweetRenderEntity) (int, int, bool) {
+	length := len([]rune(entity.match))
+	if len(entity.prefix) > 0 {
+		length++
+	}
+	if entity.start >= cursor && entity.start+length <= len(text) && matchTweetEntity(text[entity.start:entity.start+length], entity) {
+		return entity.start, entity.start + length, true
+	}
+	for start := cursor; start+length <= len(text); start++ {
+		if matchTweetEntity(text[start:start+length], entity) {
+			return start, start + length, true
+		}
+	}
+	return 0, 0, false
+}
+
+func matchTweetEntity(text []rune, entity tweetRenderEntity) bool {
+	if len(entity.prefix) == 0 {
+		return string(text) == entity.match
+	}
+	for _, r := range entity.prefix {
+		if text[0] == r {
+			return strings.EqualFold(string(text[1:]), entity.match)
+		}
+	}
+	return false
+}
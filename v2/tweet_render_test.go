@@ -0,0 +1,160 @@
+package twitter
+
+import (
+	"testing"
+)
+
+func TestTweetDictionary_Render(t *testing.T) {
+	tests := []struct {
+		name         string
+		dictionary   *TweetDictionary
+		opts         TweetRenderOpts
+		wantHTML     string
+		wantMarkdown string
+	}{
+		{
+			name: "entities",
+			dictionary: &TweetDictionary{
+				Tweet: TweetObj{
+					Text: "Hi @TwitterDev 👋🏽 see https://t.co/abc #golang &amp; $TWTR",
+					Entities: &EntitiesObj{
+						Mentions: []EntityMentionObj{
+							{EntityObj: EntityObj{Start: 3, End: 14}, UserName: "TwitterDev"},
+						},
+						URLs: []EntityURLObj{
+							{
+								EntityObj:   EntityObj{Start: 22, End: 38},
+								URL:         "https://t.co/abc",
+								ExpandedURL: "https://go.dev/doc",
+								DisplayURL:  "go.dev/doc",
+							},
+						},
+						HashTags: []EntityTagObj{
+							{EntityObj: EntityObj{Start: 39, End: 46}, Tag: "golang"},
+						},
+						CashTags: []EntityTagObj{
+							{EntityObj: EntityObj{Start: 49, End: 54}, Tag: "TWTR"},
+						},
+					},
+				},
+			},
+			wantHTML: `Hi <a href="https://twitter.com/TwitterDev">@TwitterDev</a> 👋🏽 see <a href="https://go.dev/doc">go.dev/doc</a> ` +
+				`<a href="https://twitter.com/hashtag/golang">#golang</a> &amp; <a href="https://twitter.com/search?q=%24TWTR">$TWTR</a>`,
+			wantMarkdown: `Hi [@TwitterDev](https://twitter.com/TwitterDev) 👋🏽 see [go.dev/doc](https://go.dev/doc) ` +
+				`[\#golang](https://twitter.com/hashtag/golang) & [$TWTR](https://twitter.com/search?q=%24TWTR)`,
+		},
+		{
+			name: "unsafe url scheme",
+			dictionary: &TweetDictionary{
+				Tweet: TweetObj{
+					Text: "see https://t.co/x and https://t.co/y",
+					Entities: &EntitiesObj{
+						URLs: []EntityURLObj{
+							{
+								EntityObj:   EntityObj{Start: 4, End: 18},
+								URL:         "https://t.co/x",
+								ExpandedURL: "javascript:alert(1)",
+								DisplayURL:  "click",
+							},
+							{
+								EntityObj:   EntityObj{Start: 23, End: 37},
+								URL:         "https://t.co/y",
+								ExpandedURL: "HTTP://go.dev",
+								DisplayURL:  "go.dev",
+							},
+						},
+					},
+				},
+			},
+			wantHTML:     `see click and <a href="HTTP://go.dev">go.dev</a>`,
+			wantMarkdown: `see click and [go.dev](HTTP://go.dev)`,
+		},
+		{
+			name: "escaped text offsets",
+			dictionary: &TweetDictionary{
+				Tweet: TweetObj{
+					Text: "a &lt;b&gt; @dev",
+					Entities: &EntitiesObj{
+						Mentions: []EntityMentionObj{
+							{EntityObj: EntityObj{Start: 6, End: 10}, UserName: "dev"},
+						},
+					},
+				},
+			},
+			wantHTML:     `a &lt;b&gt; <a href="https://twitter.com/dev">@dev</a>`,
+			wantMarkdown: `a \<b\> [@dev](https://twitter.com/dev)`,
+		},
+		{
+			name: "offsets counted in utf-16",
+			dictionary: &TweetDictionary{
+				Tweet: TweetObj{
+					Text: "🐹🐹 #golang",
+					Entities: &EntitiesObj{
+						HashTags: []EntityTagObj{
+							{EntityObj: EntityObj{Start: 5, End: 12}, Tag: "golang"},
+						},
+					},
+				},
+			},
+			wantHTML:     `🐹🐹 <a href="https://twitter.com/hashtag/golang">#golang</a>`,
+			wantMarkdown: `🐹🐹 [\#golang](https://twitter.com/hashtag/golang)`,
+		},
+		{
+			name: "media url",
+			dictionary: &TweetDictionary{
+				Tweet: TweetObj{
+					Text: "photo https://t.co/pic",
+					Entities: &EntitiesObj{
+						URLs: []EntityURLObj{
+							{
+								EntityObj:   EntityObj{Start: 6, End: 22},
+								URL:         "https://t.co/pic",
+								ExpandedURL: "https://twitter.com/dev/status/1/photo/1",
+								DisplayURL:  "pic.twitter.com/pic",
+								MediaKey:    "3_1",
+							},
+						},
+					},
+				},
+			},
+			wantHTML:     "photo",
+			wantMarkdown: "photo",
+		},
+		{
+			name: "link func",
+			dictionary: &TweetDictionary{
+				Tweet: TweetObj{
+					Text: "@dev #go <script>",
+					Entities: &EntitiesObj{
+						Mentions: []EntityMentionObj{
+							{EntityObj: EntityObj{Start: 0, End: 4}, UserName: "dev"},
+						},
+						HashTags: []EntityTagObj{
+							{EntityObj: EntityObj{Start: 5, End: 8}, Tag: "go"},
+						},
+					},
+				},
+			},
+			opts: TweetRenderOpts{
+				LinkFunc: func(link TweetLink) string {
+					if link.Kind == TweetLinkMention {
+						return "/users/" + link.Value
+					}
+					return ""
+				},
+			},
+			wantHTML:     `<a href="/users/dev">@dev</a> #go &lt;script&gt;`,
+			wantMarkdown: `[@dev](/users/dev) \#go \<script\>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dictionary.RenderHTML(tt.opts); got != tt.wantHTML {
+				t.Errorf("TweetDictionary.RenderHTML() = %v, want %v", got, tt.wantHTML)
+			}
+			if got := tt.dictionary.RenderMarkdown(tt.opts); got != tt.wantMarkdown {
+				t.Errorf("TweetDictionary.RenderMarkdown() = %v, want %v", got, tt.wantMarkdown)
+			}
+		})
+	}
+}
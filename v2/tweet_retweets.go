@@ -0,0 +1,69 @@
+package twitter
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// TweetRetweetsLookupOpts are the options for the retweets of a tweet
+type TweetRetweetsLookupOpts struct {
+	MaxResults      int
+	PaginationToken string
+	TweetSelection
+}
+
+func (t TweetRetweetsLookupOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	t.TweetSelection.addQuery(q)
+	if t.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(t.MaxResults))
+	}
+	if len(t.PaginationToken) > 0 {
+		q.Add("pagination_token", t.PaginationToken)
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// TweetRetweetsLookupResponse is the response from the retweets of a tweet
+type TweetRetweetsLookupResponse struct {
+	Raw       *TweetRaw
+	Meta      *TweetRetweetsMeta
+	RateLimit *RateLimit
+}
+
+// TweetRetweetsMeta is the meta data from the retweets and the reposts of me responses
+type TweetRetweetsMeta struct {
+	ResultCount   int    `json:"result_count"`
+	NextToken     string `json:"next_token"`
+	PreviousToken string `json:"previous_token"`
+}
+
+// UserRepostsOfMeOpts are the options for the reposts of the authorized user's tweets
+type UserRepostsOfMeOpts struct {
+	MaxResults      int
+	PaginationToken string
+	TweetSelection
+}
+
+func (u UserRepostsOfMeOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	u.TweetSelection.addQuery(q)
+	if u.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(u.MaxResults))
+	}
+	if len(u.PaginationToken) > 0 {
+		q.Add("pagination_token", u.PaginationToken)
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// UserRepostsOfMeResponse is the response from the reposts of the authorized user's tweets
+type UserRepostsOfMeResponse struct {
+	Raw       *TweetRaw
+	Meta      *TweetRetweetsMeta
+	RateLimit *RateLimit
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 )
 
@@ -18,14 +17,10 @@ const (
 	TweetSearchSortOrderRelevancy TweetSearchSortOrder = "relevancy"
 )
 
-// TweetRecentSearchOpts are the optional parameters for the recent search API
+// TweetRecentSearchOpts are the optional parameters for the recent search API.  SinceIDTime and UntilIDTime are
+// converted to the since and until ids when the ids are not set.
 type TweetRecentSearchOpts struct {
-	Expansions  []Expansion
-	MediaFields []MediaField
-	PlaceFields []PlaceField
-	PollFields  []PollField
-	TweetFields []TweetField
-	UserFields  []UserField
+	TweetSelection
 	StartTime   time.Time
 	EndTime     time.Time
 	SortOrder   TweetSearchSortOrder
@@ -33,28 +28,13 @@ type TweetRecentSearchOpts struct {
 	NextToken   string
 	SinceID     string
 	UntilID     string
+	SinceIDTime time.Time
+	UntilIDTime time.Time
 }
 
 func (t TweetRecentSearchOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(t.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(t.Expansions), ","))
-	}
-	if len(t.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(t.MediaFields), ","))
-	}
-	if len(t.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(t.PlaceFields), ","))
-	}
-	if len(t.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(t.PollFields), ","))
-	}
-	if len(t.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(t.TweetFields), ","))
-	}
-	if len(t.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(t.UserFields), ","))
-	}
+	t.TweetSelection.addQuery(q)
 	if !t.StartTime.IsZero() {
 		q.Add("start_time", t.StartTime.Format(time.RFC3339))
 	}
@@ -67,11 +47,11 @@ func (t TweetRecentSearchOpts) addQuery(req *http.Request) {
 	if len(t.NextToken) > 0 {
 		q.Add("next_token", t.NextToken)
 	}
-	if len(t.SinceID) > 0 {
-		q.Add("since_id", t.SinceID)
+	if sinceID := sinceIDParam(t.SinceID, t.SinceIDTime); len(sinceID) > 0 {
+		q.Add("since_id", sinceID)
 	}
-	if len(t.UntilID) > 0 {
-		q.Add("until_id", t.UntilID)
+	if untilID := untilIDParam(t.UntilID, t.UntilIDTime); len(untilID) > 0 {
+		q.Add("until_id", untilID)
 	}
 	if len(t.SortOrder) > 0 {
 		q.Add("sort_order", string(t.SortOrder))
@@ -98,41 +78,19 @@ type TweetRecentSearchMeta struct {
 
 // TweetSearchOpts are the tweet search options
 type TweetSearchOpts struct {
-	Expansions  []Expansion
-	MediaFields []MediaField
-	PlaceFields []PlaceField
-	PollFields  []PollField
-	TweetFields []TweetField
-	UserFields  []UserField
-	StartTime   time.Time
-	EndTime     time.Time
-	SortOrder   TweetSearchSortOrder
-	MaxResults  int
-	NextToken   string
-	SinceID     string
-	UntilID     string
+	TweetSelection
+	StartTime  time.Time
+	EndTime    time.Time
+	SortOrder  TweetSearchSortOrder
+	MaxResults int
+	NextToken  string
+	SinceID    string
+	UntilID    string
 }
 
 func (t TweetSearchOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(t.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(t.Expansions), ","))
-	}
-	if len(t.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(t.MediaFields), ","))
-	}
-	if len(t.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(t.PlaceFields), ","))
-	}
-	if len(t.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(t.PollFields), ","))
-	}
-	if len(t.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(t.TweetFields), ","))
-	}
-	if len(t.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(t.UserFields), ","))
-	}
+	t.TweetSelection.addQuery(q)
 	if !t.StartTime.IsZero() {
 		q.Add("start_time", t.StartTime.Format(time.RFC3339))
 	}
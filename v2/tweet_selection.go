@@ -0,0 +1,232 @@
+package twitter
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// TweetSelection is the expansions and fields that are requested with the tweets.  It is embedded in the options
+// of the tweet callouts, so a preset can be set as the selection of the options or merged with other fields.
+type TweetSelection struct {
+	Expansions  []Expansion
+	MediaFields []MediaField
+	PlaceFields []PlaceField
+	PollFields  []PollField
+	TweetFields []TweetField
+	UserFields  []UserField
+}
+
+// TweetSelectionMinimal is the tweet text with the author
+func TweetSelectionMinimal() TweetSelection {
+	return TweetSelection{
+		Expansions:  []Expansion{ExpansionAuthorID},
+		TweetFields: []TweetField{TweetFieldID, TweetFieldText, TweetFieldAuthorID, TweetFieldCreatedAt},
+		UserFields:  []UserField{UserFieldID, UserFieldName, UserFieldUserName},
+	}
+}
+
+// TweetSelectionPublic is all of the expansions and the fields that are available with app only authorization
+func TweetSelectionPublic() TweetSelection {
+	return TweetSelection{
+		Expansions: []Expansion{
+			ExpansionAttachmentsPollIDs,
+			ExpansionAttachmentsMediaKeys,
+			ExpansionAuthorID,
+			ExpansionEntitiesMentionsUserName,
+			ExpansionGeoPlaceID,
+			ExpansionInReplyToUserID,
+			ExpansionReferencedTweetsID,
+			ExpansionReferencedTweetsIDAuthorID,
+			ExpansionEditHistoryTweetIDs,
+		},
+		MediaFields: []MediaField{
+			MediaFieldDurationMS,
+			MediaFieldHeight,
+			MediaFieldMediaKey,
+			MediaFieldPreviewImageURL,
+			MediaFieldType,
+			MediaFieldURL,
+			MediaFieldWidth,
+			MediaFieldPublicMetrics,
+			MediaFieldAltText,
+			MediaFieldVariants,
+		},
+		PlaceFields: []PlaceField{
+			PlaceFieldContainedWithin,
+			PlaceFieldCountry,
+			PlaceFieldCountryCode,
+			PlaceFieldFullName,
+			PlaceFieldGeo,
+			PlaceFieldID,
+			PlaceFieldName,
+			PlaceFieldPlaceType,
+		},
+		PollFields: []PollField{
+			PollFieldDurationMinutes,
+			PollFieldEndDateTime,
+			PollFieldID,
+			PollFieldOptions,
+			PollFieldVotingStatus,
+		},
+		TweetFields: []TweetField{
+			TweetFieldID,
+			TweetFieldText,
+			TweetFieldAttachments,
+			TweetFieldAuthorID,
+			TweetFieldContextAnnotations,
+			TweetFieldConversationID,
+			TweetFieldCreatedAt,
+			TweetFieldEntities,
+			TweetFieldGeo,
+			TweetFieldInReplyToUserID,
+			TweetFieldLanguage,
+			TweetFieldPublicMetrics,
+			TweetFieldPossiblySensitve,
+			TweetFieldReferencedTweets,
+			TweetFieldSource,
+			TweetFieldWithHeld,
+			TweetFieldEditHistoryTweetIDs,
+			TweetFieldEditControls,
+			TweetFieldNoteTweet,
+		},
+		UserFields: []UserField{
+			UserFieldCreatedAt,
+			UserFieldDescription,
+			UserFieldEntities,
+			UserFieldID,
+			UserFieldLocation,
+			UserFieldName,
+			UserFieldPinnedTweetID,
+			UserFieldProfileImageURL,
+			UserFieldProtected,
+			UserFieldPublicMetrics,
+			UserFieldURL,
+			UserFieldUserName,
+			UserFieldVerified,
+			UserFieldWithHeld,
+		},
+	}
+}
+
+// TweetSelectionWithMetrics is the minimal selection with the public, non public and organic metrics of the
+// tweets and the media.  The non public and organic metrics require user context authorization.
+func TweetSelectionWithMetrics() TweetSelection {
+	return TweetSelectionMinimal().Merge(TweetSelection{
+		Expansions: []Expansion{ExpansionAttachmentsMediaKeys},
+		MediaFields: []MediaField{
+			MediaFieldMediaKey,
+			MediaFieldType,
+			MediaFieldPublicMetrics,
+			MediaFieldNonPublicMetrics,
+			MediaFieldOrganicMetrics,
+		},
+		TweetFields: []TweetField{
+			TweetFieldPublicMetrics,
+			TweetFieldNonPublicMetrics,
+			TweetFieldOrganicMetrics,
+		},
+	})
+}
+
+// Merge will return the expansions and fields of both selections, without duplicates
+func (s TweetSelection) Merge(other TweetSelection) TweetSelection {
+	merged := TweetSelection{}
+	seen := map[string]bool{}
+	add := func(param, value string) bool {
+		key := param + "=" + value
+		if seen[key] {
+			return false
+		}
+		seen[key] = true
+		return true
+	}
+	for _, selection := range []TweetSelection{s, other} {
+		for _, expansion := range selection.Expansions {
+			if add("expansions", string(expansion)) {
+				merged.Expansions = append(merged.Expansions, expansion)
+			}
+		}
+		for _, field := range selection.MediaFields {
+			if add("media.fields", string(field)) {
+				merged.MediaFields = append(merged.MediaFields, field)
+			}
+		}
+		for _, field := range selection.PlaceFields {
+			if add("place.fields", string(field)) {
+				merged.PlaceFields = append(merged.PlaceFields, field)
+			}
+		}
+		for _, field := range selection.PollFields {
+			if add("poll.fields", string(field)) {
+				merged.PollFields = append(merged.PollFields, field)
+			}
+		}
+		for _, field := range selection.TweetFields {
+			if add("tweet.fields", string(field)) {
+				merged.TweetFields = append(merged.TweetFields, field)
+			}
+		}
+		for _, field := range selection.UserFields {
+			if add("user.fields", string(field)) {
+				merged.UserFields = append(merged.UserFields, field)
+			}
+		}
+	}
+	return merged
+}
+
+// Validate will check that the fields are available to the authorization context.  The non public, organic and
+// promoted metrics are not available with app only authorization.
+func (s TweetSelection) Validate(authContext AuthContext) error {
+	if authContext != AuthContextApp {
+		return nil
+	}
+	errs := FieldErrors{}
+	for _, field := range s.TweetFields {
+		switch field {
+		case TweetFieldNonPublicMetrics, TweetFieldOrganicMetrics, TweetFieldPromotedMetrics:
+			errs = append(errs, &FieldError{Field: "tweet.fields", Message: fmt.Sprintf("[%s] requires user context authorization", field)})
+		default:
+		}
+	}
+	for _, field := range s.MediaFields {
+		switch field {
+		case MediaFieldNonPublicMetrics, MediaFieldOrganicMetrics, MediaFieldPromotedMetrics:
+			errs = append(errs, &FieldError{Field: "media.fields", Message: fmt.Sprintf("[%s] requires user context authorization", field)})
+		default:
+		}
+	}
+	return errs.err()
+}
+
+func (s TweetSelection) addQuery(q url.Values) {
+	if len(s.Expansions) > 0 {
+		q.Add("expansions", strings.Join(expansionStringArray(s.Expansions), ","))
+	}
+	if len(s.MediaFields) > 0 {
+		q.Add("media.fields", strings.Join(mediaFieldStringArray(s.MediaFields), ","))
+	}
+	if len(s.PlaceFields) > 0 {
+		q.Add("place.fields", strings.Join(placeFieldStringArray(s.PlaceFields), ","))
+	}
+	if len(s.PollFields) > 0 {
+		q.Add("poll.fields", strings.Join(pollFieldStringArray(s.PollFields), ","))
+	}
+	if len(s.TweetFields) > 0 {
+		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(s.TweetFields), ","))
+	}
+	if len(s.UserFields) > 0 {
+		q.Add("user.fields", strings.Join(userFieldStringArray(s.UserFields), ","))
+	}
+}
+
+// validateTweetSelection will reject the fields that are not available to the authorization context of the
+// client.  The context is the client's setting, or the authorizer's if it knows its context.
+func (c *Client) validateTweetSelection(selection TweetSelection) error {
+	authContext := c.AuthContext
+	if authorizer, ok := c.Authorizer.(AuthContextAuthorizer); ok && len(authContext) == 0 {
+		authContext = authorizer.AuthContext()
+	}
+	return selection.Validate(authContext)
+}
@@ -0,0 +1,262 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTweetSelection_Merge(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection TweetSelection
+		other     TweetSelection
+		want      TweetSelection
+	}{
+		{
+			name:      "empty",
+			selection: TweetSelection{},
+			other:     TweetSelection{},
+			want:      TweetSelection{},
+		},
+		{
+			name: "duplicates",
+			selection: TweetSelection{
+				Expansions:  []Expansion{ExpansionAuthorID},
+				TweetFields: []TweetField{TweetFieldID, TweetFieldText},
+			},
+			other: TweetSelection{
+				Expansions:  []Expansion{ExpansionAuthorID, ExpansionGeoPlaceID},
+				PlaceFields: []PlaceField{PlaceFieldID},
+				TweetFields: []TweetField{TweetFieldText, TweetFieldLanguage},
+			},
+			want: TweetSelection{
+				Expansions:  []Expansion{ExpansionAuthorID, ExpansionGeoPlaceID},
+				PlaceFields: []PlaceField{PlaceFieldID},
+				TweetFields: []TweetField{TweetFieldID, TweetFieldText, TweetFieldLanguage},
+			},
+		},
+		{
+			name: "same value different fields",
+			selection: TweetSelection{
+				TweetFields: []TweetField{TweetFieldPublicMetrics},
+			},
+			other: TweetSelection{
+				MediaFields: []MediaField{MediaFieldPublicMetrics},
+				UserFields:  []UserField{UserFieldPublicMetrics},
+			},
+			want: TweetSelection{
+				MediaFields: []MediaField{MediaFieldPublicMetrics},
+				TweetFields: []TweetField{TweetFieldPublicMetrics},
+				UserFields:  []UserField{UserFieldPublicMetrics},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.selection.Merge(tt.other); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TweetSelection.Merge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTweetSelection_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		selection   TweetSelection
+		authContext AuthContext
+		wantFields  []string
+	}{
+		{
+			name:        "public with app",
+			selection:   TweetSelectionPublic(),
+			authContext: AuthContextApp,
+		},
+		{
+			name:        "metrics with user",
+			selection:   TweetSelectionWithMetrics(),
+			authContext: AuthContextUser,
+		},
+		{
+			name:      "metrics with unknown context",
+			selection: TweetSelectionWithMetrics(),
+		},
+		{
+			name:        "metrics with app",
+			selection:   TweetSelectionWithMetrics(),
+			authContext: AuthContextApp,
+			wantFields:  []string{"tweet.fields", "tweet.fields", "media.fields", "media.fields"},
+		},
+		{
+			name: "promoted with app",
+			selection: TweetSelection{
+				TweetFields: []TweetField{TweetFieldID, TweetFieldPromotedMetrics},
+			},
+			authContext: AuthContextApp,
+			wantFields:  []string{"tweet.fields"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.selection.Validate(tt.authContext)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("TweetSelection.Validate() error = %v", err)
+				}
+				return
+			}
+			var fieldErrs FieldErrors
+			if !errors.As(err, &fieldErrs) || !errors.Is(err, ErrParameter) {
+				t.Fatalf("TweetSelection.Validate() error = %v, want FieldErrors", err)
+			}
+			fields := make([]string, len(fieldErrs))
+			for i, fieldErr := range fieldErrs {
+				fields[i] = fieldErr.Field
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("TweetSelection.Validate() fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestClient_TweetSelection(t *testing.T) {
+	type fields struct {
+		Authorizer  Authorizer
+		Client      *http.Client
+		AuthContext AuthContext
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		opts    TweetLookupOpts
+		wantErr bool
+	}{
+		{
+			name: "merged selection",
+			fields: fields{
+				Authorizer: &mockContextAuth{authContext: AuthContextApp},
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					q := req.URL.Query()
+					if q.Get("tweet.fields") != "lang,text,id,author_id,created_at" || q.Get("expansions") != "author_id" || q.Get("user.fields") != "id,name,username" {
+						log.Panicf("the query is not correct %s", req.URL.String())
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"1","text":"hello"}}`)),
+						Header:     http.Header{},
+					}
+				}),
+			},
+			opts: TweetLookupOpts{
+				TweetSelection: TweetSelection{
+					TweetFields: []TweetField{TweetFieldLanguage, TweetFieldText},
+				}.Merge(TweetSelectionMinimal()),
+			},
+		},
+		{
+			name: "metrics with app only",
+			fields: fields{
+				Authorizer: &mockContextAuth{authContext: AuthContextApp},
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be made %s", req.URL.String())
+					return nil
+				}),
+			},
+			opts: TweetLookupOpts{
+				TweetSelection: TweetSelectionWithMetrics(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "non public metrics with app only",
+			fields: fields{
+				Authorizer: &mockContextAuth{authContext: AuthContextApp},
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be made %s", req.URL.String())
+					return nil
+				}),
+			},
+			opts: TweetLookupOpts{
+				TweetSelection: TweetSelection{
+					TweetFields: []TweetField{TweetFieldNonPublicMetrics},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "metrics with client app context",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be made %s", req.URL.String())
+					return nil
+				}),
+				AuthContext: AuthContextApp,
+			},
+			opts: TweetLookupOpts{
+				TweetSelection: TweetSelectionWithMetrics(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "client context over the authorizer",
+			fields: fields{
+				Authorizer: &mockContextAuth{authContext: AuthContextApp},
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"1","text":"hello"}}`)),
+						Header:     http.Header{},
+					}
+				}),
+				AuthContext: AuthContextUser,
+			},
+			opts: TweetLookupOpts{
+				TweetSelection: TweetSelectionWithMetrics(),
+			},
+		},
+		{
+			name: "metrics with user context",
+			fields: fields{
+				Authorizer: &mockContextAuth{authContext: AuthContextUser},
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if !strings.Contains(req.URL.Query().Get("tweet.fields"), "non_public_metrics") {
+						log.Panicf("the query is not correct %s", req.URL.String())
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"1","text":"hello"}}`)),
+						Header:     http.Header{},
+					}
+				}),
+			},
+			opts: TweetLookupOpts{
+				TweetSelection: TweetSelectionWithMetrics(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer:  tt.fields.Authorizer,
+				Client:      tt.fields.Client,
+				Host:        "https://www.test.com",
+				AuthContext: tt.fields.AuthContext,
+			}
+			_, err := c.TweetLookup(context.Background(), []string{"1"}, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Client.TweetLookup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, ErrParameter) {
+				t.Errorf("Client.TweetLookup() error = %v, want %v", err, ErrParameter)
+			}
+		})
+	}
+}
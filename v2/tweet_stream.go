@@ -5,11 +5,11 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
 	"sync"
 	"time"
 )
@@ -30,7 +30,6 @@ const (
 	// ErrorMessageType is the error system message type
 	ErrorMessageType SystemMessageType = "error"
 
-	tweetStart  = "data"
 	keepAliveTO = 21 * time.Second
 
 	// TweetErrorType represents the tweet stream errors
@@ -40,9 +39,6 @@ const (
 	// DisconnectErrorType represents the disconnection errors
 	DisconnectErrorType StreamErrorType = "disconnect"
 
-	disconnectionErrorsKey = "errors"
-	disconnectionTitleKey  = "title"
-
 	decodeErrStream   streamType = -1
 	tweetStream       streamType = 1
 	systemMsgStream   streamType = 2
@@ -53,34 +49,13 @@ const (
 // TweetSampleStreamOpts are the options for sample tweet stream
 type TweetSampleStreamOpts struct {
 	BackfillMinutes int
-	Expansions      []Expansion
-	MediaFields     []MediaField
-	PlaceFields     []PlaceField
-	PollFields      []PollField
-	TweetFields     []TweetField
-	UserFields      []UserField
+	TweetSelection
+	Deduplicator *TweetDeduplicator
 }
 
 func (t TweetSampleStreamOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(t.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(t.Expansions), ","))
-	}
-	if len(t.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(t.MediaFields), ","))
-	}
-	if len(t.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(t.PlaceFields), ","))
-	}
-	if len(t.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(t.PollFields), ","))
-	}
-	if len(t.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(t.TweetFields), ","))
-	}
-	if len(t.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(t.UserFields), ","))
-	}
+	t.TweetSelection.addQuery(q)
 	if t.BackfillMinutes > 0 {
 		q.Add("backfill_minutes", strconv.Itoa(t.BackfillMinutes))
 	}
@@ -92,34 +67,13 @@ func (t TweetSampleStreamOpts) addQuery(req *http.Request) {
 // TweetSearchStreamOpts are the options for the search stream
 type TweetSearchStreamOpts struct {
 	BackfillMinutes int
-	Expansions      []Expansion
-	MediaFields     []MediaField
-	PlaceFields     []PlaceField
-	PollFields      []PollField
-	TweetFields     []TweetField
-	UserFields      []UserField
+	TweetSelection
+	Deduplicator *TweetDeduplicator
 }
 
 func (t TweetSearchStreamOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(t.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(t.Expansions), ","))
-	}
-	if len(t.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(t.MediaFields), ","))
-	}
-	if len(t.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(t.PlaceFields), ","))
-	}
-	if len(t.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(t.PollFields), ","))
-	}
-	if len(t.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(t.TweetFields), ","))
-	}
-	if len(t.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(t.UserFields), ","))
-	}
+	t.TweetSelection.addQuery(q)
 	if t.BackfillMinutes > 0 {
 		q.Add("backfill_minutes", strconv.Itoa(t.BackfillMinutes))
 	}
@@ -229,11 +183,22 @@ type TweetStream struct {
 	err           chan error
 	alive         bool
 	mutex         sync.RWMutex
+	deduplicator  *TweetDeduplicator
+	counter       *TweetUsageCounter
 	RateLimit     *RateLimit
 }
 
 // StartTweetStream will start the tweet streaming
 func StartTweetStream(stream io.ReadCloser) *TweetStream {
+	return startTweetStream(stream, nil, nil)
+}
+
+// StartTweetStreamWithDeduplicator will start the tweet streaming and suppress any tweets already seen by the deduplicator
+func StartTweetStreamWithDeduplicator(stream io.ReadCloser, deduplicator *TweetDeduplicator) *TweetStream {
+	return startTweetStream(stream, deduplicator, nil)
+}
+
+func startTweetStream(stream io.ReadCloser, deduplicator *TweetDeduplicator, counter *TweetUsageCounter) *TweetStream {
 	ts := &TweetStream{
 		tweets:        make(chan *TweetMessage, 10),
 		system:        make(chan map[SystemMessageType]SystemMessage, 10),
@@ -242,6 +207,8 @@ func StartTweetStream(stream io.ReadCloser) *TweetStream {
 		err:           make(chan error),
 		mutex:         sync.RWMutex{},
 		alive:         true,
+		deduplicator:  deduplicator,
+		counter:       counter,
 	}
 
 	go ts.handle(stream)
@@ -269,15 +236,20 @@ func (ts *TweetStream) handle(stream io.ReadCloser) {
 	defer close(ts.close)
 	defer close(ts.err)
 
+	scanStream(stream, ts.close, ts.heartbeat, ts.handleFrame)
+}
+
+// scanStream will read the stream messages until closed.  The heartbeat is updated from the keep alive timeout.
+func scanStream(stream io.Reader, closeStream <-chan bool, heartbeat func(bool), handleFrame func([]byte)) {
 	scanner := bufio.NewScanner(stream)
 	scanner.Split(streamSeparator)
 	timer := time.NewTimer(keepAliveTO)
 	for {
 		select {
-		case <-ts.close:
+		case <-closeStream:
 			return
 		case <-timer.C:
-			ts.heartbeat(false)
+			heartbeat(false)
 		default:
 		}
 
@@ -287,7 +259,7 @@ func (ts *TweetStream) handle(stream io.ReadCloser) {
 
 		timer.Stop()
 		timer.Reset(keepAliveTO)
-		ts.heartbeat(true)
+		heartbeat(true)
 
 		msg := scanner.Bytes()
 
@@ -295,164 +267,90 @@ func (ts *TweetStream) handle(stream io.ReadCloser) {
 			continue
 		}
 
-		reader, err := normalizeStream(msg)
-		if err != nil {
-			select {
-			case ts.err <- fmt.Errorf("stream error: normalize error %w", err):
-			default:
-			}
-			continue
-		}
-
-		sType, err := decodeStreamType(reader)
-		if err != nil {
-			select {
-			case ts.err <- fmt.Errorf("stream error: unmarshal error %w", err):
-			default:
-			}
-			continue
-		}
-		if _, err := reader.Seek(0, io.SeekStart); err != nil {
-			select {
-			case ts.err <- fmt.Errorf("stream error: seek error %w", err):
-			default:
-			}
-			continue
-		}
-		decoder := json.NewDecoder(reader)
-
-		switch sType {
-		case tweetStream:
-			ts.handleTweet(decoder)
-		case systemMsgStream:
-			ts.handleSystemMessage(decoder)
-		case disconnectionErrs:
-			ts.handleDisconnectErrors(decoder)
-		case disconnectionErr:
-			ts.handleDisconnectError(decoder)
-		default:
-		}
+		handleFrame(msg)
 	}
 }
 
-func (ts *TweetStream) handleTweet(decoder *json.Decoder) {
-	single := &tweetraw{}
-	if err := decoder.Decode(single); err != nil {
-		sErr := &StreamError{
-			Type: TweetErrorType,
-			Msg:  "unmarshal tweet stream",
-			Err:  err,
-		}
-		select {
-		case ts.err <- sErr:
-		default:
-		}
+func (ts *TweetStream) handleFrame(msg []byte) {
+	msg, err := normalizeStream(msg)
+	if err != nil {
+		ts.sendErr(fmt.Errorf("stream error: normalize error %w", err))
 		return
 	}
-	raw := &TweetRaw{}
-	raw.Tweets = make([]*TweetObj, 1)
-	raw.Tweets[0] = single.Tweet
-	raw.Includes = single.Includes
-	raw.Errors = single.Errors
 
-	tweetMsg := &TweetMessage{
-		Raw: raw,
+	frame := &streamFrame{}
+	if err := json.Unmarshal(msg, frame); err != nil {
+		ts.sendErr(fmt.Errorf("stream error: unmarshal error %w", err))
+		return
 	}
 
-	select {
-	case ts.tweets <- tweetMsg:
+	switch frame.streamType() {
+	case tweetStream:
+		ts.handleTweet(frame)
+	case systemMsgStream:
+		ts.handleSystemMessage(&frame.streamControl)
+	case disconnectionErrs, disconnectionErr:
+		ts.handleDisconnection(&frame.streamControl)
 	default:
+		ts.sendErr(fmt.Errorf("stream error: unmarshal error %w", errors.New("decode stream message")))
 	}
 }
 
-func (ts *TweetStream) handleSystemMessage(decoder *json.Decoder) {
-	sysMsg := map[SystemMessageType]SystemMessage{}
-	if err := decoder.Decode(&sysMsg); err != nil {
-		sErr := &StreamError{
-			Type: SystemErrorType,
-			Msg:  "unmarshal system stream",
-			Err:  err,
-		}
-		select {
-		case ts.err <- sErr:
-		default:
-		}
-		return
-	}
+func (ts *TweetStream) sendErr(err error) {
 	select {
-	case ts.system <- sysMsg:
+	case ts.err <- err:
 	default:
 	}
-
 }
 
-func (ts *TweetStream) handleDisconnectErrors(decoder *json.Decoder) {
-	disErrs := struct {
-		Errors []disconnection `json:"errors"`
-	}{}
-	if err := decoder.Decode(&disErrs); err != nil {
-		sErr := &StreamError{
-			Type: DisconnectErrorType,
-			Msg:  "unmarshal disconnect stream",
-			Err:  err,
-		}
-		select {
-		case ts.err <- sErr:
-		default:
+func (ts *TweetStream) handleTweet(frame *streamFrame) {
+	raw := &TweetRaw{}
+	if len(frame.Errors) > 0 {
+		if err := json.Unmarshal(frame.Errors, &raw.Errors); err != nil {
+			ts.sendErr(&StreamError{
+				Type: TweetErrorType,
+				Msg:  "unmarshal tweet stream",
+				Err:  err,
+			})
+			return
 		}
+	}
+	// twitter counts every delivered tweet against the cap, even the duplicates
+	ts.counter.Add(TweetUsageSourceStream, 1)
+	if ts.deduplicator != nil && ts.deduplicator.Duplicate(frame.Tweet.ID) {
 		return
 	}
+	raw.Tweets = []*TweetObj{frame.Tweet}
+	raw.Includes = frame.Includes
 
-	ds := &DisconnectionError{
-		Disconnections: []*Disconnection{},
-		Connections:    []*Connection{},
-	}
-	for _, d := range disErrs.Errors {
-		switch {
-		case d.disconnectType():
-			ds.Disconnections = append(ds.Disconnections, d.toDisconnection())
-		default:
-			ds.Connections = append(ds.Connections, d.toConnection())
-		}
+	tweetMsg := &TweetMessage{
+		Raw: raw,
 	}
 
 	select {
-	case ts.disconnection <- ds:
+	case ts.tweets <- tweetMsg:
 	default:
 	}
 }
 
-func (ts *TweetStream) handleDisconnectError(decoder *json.Decoder) {
-	d := disconnection{}
-	if err := decoder.Decode(&d); err != nil {
-		sErr := &StreamError{
-			Type: DisconnectErrorType,
-			Msg:  "unmarshal disconnect stream",
-			Err:  err,
-		}
-		select {
-		case ts.err <- sErr:
-		default:
-		}
-		return
+func (ts *TweetStream) handleSystemMessage(control *streamControl) {
+	select {
+	case ts.system <- control.systemMessage():
+	default:
 	}
+}
 
-	ds := &DisconnectionError{
-		Disconnections: []*Disconnection{},
-		Connections:    []*Connection{},
-	}
-	switch {
-	case d.disconnectType():
-		ds.Disconnections = append(ds.Disconnections, d.toDisconnection())
-	default:
-		ds.Connections = append(ds.Connections, d.toConnection())
+func (ts *TweetStream) handleDisconnection(control *streamControl) {
+	ds, err := control.disconnectionError()
+	if err != nil {
+		ts.sendErr(err)
+		return
 	}
 
 	select {
 	case ts.disconnection <- ds:
 	default:
 	}
-
 }
 
 // Tweets will return the channel to receive tweet stream messages
@@ -493,37 +391,97 @@ func streamSeparator(data []byte, atEOF bool) (int, []byte, error) {
 	return 0, nil, nil
 }
 
-func decodeStreamType(reader io.Reader) (streamType, error) {
-	mm := map[string]interface{}{}
-	if err := json.NewDecoder(reader).Decode(&mm); err != nil {
-		return decodeErrStream, fmt.Errorf("decode stream type: %w", err)
-	}
-	for k := range mm {
-		switch k {
-		case tweetStart:
-			return tweetStream, nil
-		case string(InfoMessageType), string(WarnMessageType), string(ErrorMessageType):
-			return systemMsgStream, nil
-		case disconnectionErrorsKey:
-			return disconnectionErrs, nil
-		case disconnectionTitleKey:
-			return disconnectionErr, nil
-		default:
-		}
-	}
-	return decodeErrStream, fmt.Errorf("decode stream message")
+// streamControl are the system and disconnection messages that are part of every stream.  The errors are kept raw
+// as they are either partial errors or disconnection errors, depending on the message.
+type streamControl struct {
+	Errors json.RawMessage `json:"errors"`
+	Info   *SystemMessage  `json:"info"`
+	Warn   *SystemMessage  `json:"warn"`
+	Error  *SystemMessage  `json:"error"`
+	disconnection
 }
 
-func normalizeStream(msg []byte) (*bytes.Reader, error) {
-	str := string(msg)
+func (c *streamControl) streamType() streamType {
 	switch {
-	case strings.Contains(str, ":"):
-		return bytes.NewReader(msg), nil
+	case c.Info != nil || c.Warn != nil || c.Error != nil:
+		return systemMsgStream
+	case len(c.Errors) > 0:
+		return disconnectionErrs
+	case len(c.Title) > 0:
+		return disconnectionErr
+	default:
+		return decodeErrStream
+	}
+}
+
+func (c *streamControl) systemMessage() map[SystemMessageType]SystemMessage {
+	sysMsg := map[SystemMessageType]SystemMessage{}
+	if c.Info != nil {
+		sysMsg[InfoMessageType] = *c.Info
+	}
+	if c.Warn != nil {
+		sysMsg[WarnMessageType] = *c.Warn
+	}
+	if c.Error != nil {
+		sysMsg[ErrorMessageType] = *c.Error
+	}
+	return sysMsg
+}
+
+func (c *streamControl) disconnectionError() (*DisconnectionError, error) {
+	disErrs := []disconnection{}
+	switch c.streamType() {
+	case disconnectionErrs:
+		if err := json.Unmarshal(c.Errors, &disErrs); err != nil {
+			return nil, &StreamError{
+				Type: DisconnectErrorType,
+				Msg:  "unmarshal disconnect stream",
+				Err:  err,
+			}
+		}
 	default:
-		decodedMsg, err := base64.StdEncoding.DecodeString(str)
-		if err != nil {
-			return nil, fmt.Errorf("stream normalize stream base64: %w", err)
+		disErrs = append(disErrs, c.disconnection)
+	}
+
+	ds := &DisconnectionError{
+		Disconnections: []*Disconnection{},
+		Connections:    []*Connection{},
+	}
+	for _, d := range disErrs {
+		switch {
+		case d.disconnectType():
+			ds.Disconnections = append(ds.Disconnections, d.toDisconnection())
+		default:
+			ds.Connections = append(ds.Connections, d.toConnection())
 		}
-		return bytes.NewReader(decodedMsg), nil
 	}
+	return ds, nil
+}
+
+// streamFrame is used to classify and decode a tweet stream message in a single pass.
+type streamFrame struct {
+	Tweet    *TweetObj         `json:"data"`
+	Includes *TweetRawIncludes `json:"includes"`
+	streamControl
+}
+
+func (f *streamFrame) streamType() streamType {
+	if f.Tweet != nil {
+		return tweetStream
+	}
+	return f.streamControl.streamType()
+}
+
+// normalizeStream will return the JSON message.  Messages that are not a JSON object are base64 encoded.
+func normalizeStream(msg []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(msg)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		return trimmed, nil
+	}
+	decodedMsg := make([]byte, base64.StdEncoding.DecodedLen(len(trimmed)))
+	n, err := base64.StdEncoding.Decode(decodedMsg, trimmed)
+	if err != nil {
+		return nil, fmt.Errorf("stream normalize stream base64: %w", err)
+	}
+	return decodedMsg[:n], nil
 }
@@ -0,0 +1,202 @@
+package twitter
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+var benchmarkStreamFrames = map[string][]byte{
+	"tweet":      []byte(`{"data":{"id":"1067094924124872705","text":"Just getting started with Twitter APIs? Find out what you need in order to build an app. Watch this video! https://t.co/Hg8nkfoizN","author_id":"2244994945","conversation_id":"1067094924124872705","created_at":"2018-11-26T16:37:10.000Z","lang":"en","possibly_sensitive":false,"public_metrics":{"retweet_count":5,"reply_count":2,"like_count":21,"quote_count":1},"entities":{"urls":[{"start":107,"end":130,"url":"https://t.co/Hg8nkfoizN","expanded_url":"https://twitter.com/i/status/1067094924124872705","display_url":"pic.twitter.com/Hg8nkfoizN"}]},"referenced_tweets":[{"type":"quoted","id":"1067094924124872000"}]},"includes":{"users":[{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}],"tweets":[{"id":"1067094924124872000","text":"quoted tweet"}]},"matching_rules":[{"id":"1166916266197536768","tag":"twitter api"}]}`),
+	"system":     []byte(`{"error":{"message":"Forced Disconnect: Too many connections. (Allowed Connections = 2)","sent":"2017-01-11T18:12:52+00:00"}}`),
+	"disconnect": []byte(`ewoJImVycm9ycyI6IFt7CgkJInRpdGxlIjogIm9wZXJhdGlvbmFsLWRpc2Nvbm5lY3QiLAoJCSJkaXNjb25uZWN0X3R5cGUiOiAiVXBzdHJlYW1PcGVyYXRpb25hbERpc2Nvbm5lY3QiLAoJCSJkZXRhaWwiOiAiVGhpcyBzdHJlYW0gaGFzIGJlZW4gZGlzY29ubmVjdGVkIHVwc3RyZWFtIGZvciBvcGVyYXRpb25hbCByZWFzb25zLiIsCgkJInR5cGUiOiAiaHR0cHM6Ly9hcGkudHdpdHRlci5jb20vMi9wcm9ibGVtcy9vcGVyYXRpb25hbC1kaXNjb25uZWN0IgoJfV0KfQ==`),
+}
+
+// legacyDecodeStreamFrame is the two pass decoding that was used before the single pass stream frame.  It is
+// kept to compare the throughput and allocations of the stream parser.
+func legacyDecodeStreamFrame(msg []byte) (interface{}, error) {
+	var reader *bytes.Reader
+	switch str := string(msg); {
+	case strings.Contains(str, ":"):
+		reader = bytes.NewReader(msg)
+	default:
+		decodedMsg, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(decodedMsg)
+	}
+
+	mm := map[string]interface{}{}
+	if err := json.NewDecoder(reader).Decode(&mm); err != nil {
+		return nil, err
+	}
+	sType := decodeErrStream
+	for k := range mm {
+		switch k {
+		case "data":
+			sType = tweetStream
+		case string(InfoMessageType), string(WarnMessageType), string(ErrorMessageType):
+			sType = systemMsgStream
+		case "errors":
+			sType = disconnectionErrs
+		case "title":
+			sType = disconnectionErr
+		}
+		if sType != decodeErrStream {
+			break
+		}
+	}
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(reader)
+
+	switch sType {
+	case tweetStream:
+		single := &tweetraw{}
+		err := decoder.Decode(single)
+		return single, err
+	case systemMsgStream:
+		sysMsg := map[SystemMessageType]SystemMessage{}
+		err := decoder.Decode(&sysMsg)
+		return sysMsg, err
+	case disconnectionErrs:
+		disErrs := struct {
+			Errors []disconnection `json:"errors"`
+		}{}
+		err := decoder.Decode(&disErrs)
+		return disErrs, err
+	case disconnectionErr:
+		d := disconnection{}
+		err := decoder.Decode(&d)
+		return d, err
+	default:
+		return nil, fmt.Errorf("decode stream message")
+	}
+}
+
+func singlePassDecodeStreamFrame(msg []byte) (*streamFrame, error) {
+	msg, err := normalizeStream(msg)
+	if err != nil {
+		return nil, err
+	}
+	frame := &streamFrame{}
+	if err := json.Unmarshal(msg, frame); err != nil {
+		return nil, err
+	}
+	if frame.streamType() == decodeErrStream {
+		return nil, fmt.Errorf("decode stream message")
+	}
+	return frame, nil
+}
+
+func BenchmarkStreamFrameDecode(b *testing.B) {
+	for _, name := range []string{"tweet", "system", "disconnect"} {
+		frame := benchmarkStreamFrames[name]
+		b.Run(name+"/legacy", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(frame)))
+			for i := 0; i < b.N; i++ {
+				if _, err := legacyDecodeStreamFrame(frame); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(name+"/single_pass", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(frame)))
+			for i := 0; i < b.N; i++ {
+				if _, err := singlePassDecodeStreamFrame(frame); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func Test_normalizeStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     []byte
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "json",
+			msg:  []byte(` {"data":{"id":"1","text":"hello"}}`),
+			want: `{"data":{"id":"1","text":"hello"}}`,
+		},
+		{
+			name: "base64",
+			msg:  []byte(base64.StdEncoding.EncodeToString([]byte(`{"title":"ConnectionException"}`))),
+			want: `{"title":"ConnectionException"}`,
+		},
+		{
+			name:    "not json or base64",
+			msg:     []byte(`hello world`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeStream(tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("normalizeStream() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("normalizeStream() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_streamFrameType(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want streamType
+	}{
+		{
+			name: "tweet",
+			msg:  `{"data":{"id":"1","text":"hello"},"errors":[{"title":"Not Found Error"}]}`,
+			want: tweetStream,
+		},
+		{
+			name: "system",
+			msg:  `{"warn":{"message":"warning","sent":"2017-01-11T18:12:52+00:00"}}`,
+			want: systemMsgStream,
+		},
+		{
+			name: "disconnection errors",
+			msg:  `{"errors":[{"title":"operational-disconnect"}]}`,
+			want: disconnectionErrs,
+		},
+		{
+			name: "disconnection error",
+			msg:  `{"title":"ConnectionException"}`,
+			want: disconnectionErr,
+		},
+		{
+			name: "unknown",
+			msg:  `{"other":"value"}`,
+			want: decodeErrStream,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frame := &streamFrame{}
+			if err := json.Unmarshal([]byte(tt.msg), frame); err != nil {
+				t.Fatalf("streamFrame unmarshal error %v", err)
+			}
+			if got := frame.streamType(); got != tt.want {
+				t.Errorf("streamFrame.streamType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
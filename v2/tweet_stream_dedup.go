@@ -0,0 +1,181 @@
+package twitter
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+const tweetDeduplicatorDefaultSize = 100000
+
+// TweetDeduplicatorOpts are the options for the tweet deduplicator.
+//
+// Window is how long a tweet id is remembered.  If zero, the ids will not expire by time.
+//
+// MaxSize is the max number of tweet ids remembered.  If both the window and max size are zero, a default max size is used.
+type TweetDeduplicatorOpts struct {
+	Window  time.Duration
+	MaxSize int
+}
+
+// TweetDeduplicator will suppress tweets that have already been seen by the stream.  This is useful when
+// reconnecting with backfill minutes, where twitter will redeliver tweets.  The same deduplicator should be
+// passed to each stream connection, and the window can be saved and loaded to survive process restarts.
+type TweetDeduplicator struct {
+	window     time.Duration
+	maxSize    int
+	ids        map[string]*list.Element
+	order      *list.List
+	suppressed int
+	mutex      sync.Mutex
+	now        func() time.Time
+}
+
+type tweetDedupEntry struct {
+	ID   string    `json:"id"`
+	Seen time.Time `json:"seen"`
+}
+
+type tweetDedupState struct {
+	Suppressed int                `json:"suppressed"`
+	Entries    []*tweetDedupEntry `json:"entries"`
+}
+
+// NewTweetDeduplicator will create a deduplicator
+func NewTweetDeduplicator(opts TweetDeduplicatorOpts) *TweetDeduplicator {
+	maxSize := opts.MaxSize
+	if maxSize <= 0 && opts.Window <= 0 {
+		maxSize = tweetDeduplicatorDefaultSize
+	}
+	return &TweetDeduplicator{
+		window:  opts.Window,
+		maxSize: maxSize,
+		ids:     map[string]*list.Element{},
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// Duplicate will return true if the tweet id has already been seen within the window.  If the
+// id has not been seen, it will be remembered.
+func (d *TweetDeduplicator) Duplicate(id string) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := d.now()
+	d.expire(now)
+
+	if _, has := d.ids[id]; has {
+		d.suppressed++
+		return true
+	}
+	d.add(&tweetDedupEntry{
+		ID:   id,
+		Seen: now,
+	})
+	return false
+}
+
+// Suppressed returns the number of duplicate tweets that have been suppressed
+func (d *TweetDeduplicator) Suppressed() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.suppressed
+}
+
+// Len returns the number of tweet ids currently remembered
+func (d *TweetDeduplicator) Len() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.order.Len()
+}
+
+// Save will write the window of tweet ids and the suppressed count
+func (d *TweetDeduplicator) Save(w io.Writer) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.expire(d.now())
+
+	state := tweetDedupState{
+		Suppressed: d.suppressed,
+		Entries:    make([]*tweetDedupEntry, 0, d.order.Len()),
+	}
+	for e := d.order.Front(); e != nil; e = e.Next() {
+		state.Entries = append(state.Entries, e.Value.(*tweetDedupEntry))
+	}
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		return fmt.Errorf("tweet deduplicator save: %w", err)
+	}
+	return nil
+}
+
+// Load will read a previously saved window of tweet ids.  The entries are merged into the current window
+// and any entries outside of the window are dropped.
+func (d *TweetDeduplicator) Load(r io.Reader) error {
+	state := tweetDedupState{}
+	if err := json.NewDecoder(r).Decode(&state); err != nil {
+		return fmt.Errorf("tweet deduplicator load: %w", err)
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	// the file may not be in order if it was edited or merged, and expiring assumes the oldest are first
+	entries := make([]*tweetDedupEntry, 0, len(state.Entries))
+	for _, entry := range state.Entries {
+		if entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Seen.Before(entries[j].Seen)
+	})
+
+	d.suppressed += state.Suppressed
+	for _, entry := range entries {
+		if _, has := d.ids[entry.ID]; has {
+			continue
+		}
+		d.add(entry)
+	}
+	d.expire(d.now())
+	return nil
+}
+
+// add will insert the entry in the order of when it was seen, the oldest first
+func (d *TweetDeduplicator) add(entry *tweetDedupEntry) {
+	e := d.order.Back()
+	for e != nil && e.Value.(*tweetDedupEntry).Seen.After(entry.Seen) {
+		e = e.Prev()
+	}
+	if e == nil {
+		d.ids[entry.ID] = d.order.PushFront(entry)
+	} else {
+		d.ids[entry.ID] = d.order.InsertAfter(entry, e)
+	}
+	for d.maxSize > 0 && d.order.Len() > d.maxSize {
+		d.remove(d.order.Front())
+	}
+}
+
+func (d *TweetDeduplicator) expire(now time.Time) {
+	if d.window <= 0 {
+		return
+	}
+	for e := d.order.Front(); e != nil; e = d.order.Front() {
+		if now.Sub(e.Value.(*tweetDedupEntry).Seen) < d.window {
+			return
+		}
+		d.remove(e)
+	}
+}
+
+func (d *TweetDeduplicator) remove(e *list.Element) {
+	entry := d.order.Remove(e).(*tweetDedupEntry)
+	delete(d.ids, entry.ID)
+}
@@ -0,0 +1,201 @@
+package twitter
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTweetDeduplicator_Duplicate(t *testing.T) {
+	start := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	type step struct {
+		id      string
+		elapsed time.Duration
+	}
+	tests := []struct {
+		name           string
+		opts           TweetDeduplicatorOpts
+		steps          []step
+		want           []bool
+		wantSuppressed int
+		wantLen        int
+	}{
+		{
+			name: "suppress duplicates",
+			opts: TweetDeduplicatorOpts{},
+			steps: []step{
+				{id: "1"},
+				{id: "2"},
+				{id: "1"},
+				{id: "3"},
+				{id: "2"},
+			},
+			want:           []bool{false, false, true, false, true},
+			wantSuppressed: 2,
+			wantLen:        3,
+		},
+		{
+			name: "size window",
+			opts: TweetDeduplicatorOpts{
+				MaxSize: 2,
+			},
+			steps: []step{
+				{id: "1"},
+				{id: "2"},
+				{id: "3"},
+				{id: "1"},
+				{id: "3"},
+			},
+			want:           []bool{false, false, false, false, true},
+			wantSuppressed: 1,
+			wantLen:        2,
+		},
+		{
+			name: "time window",
+			opts: TweetDeduplicatorOpts{
+				Window: time.Minute,
+			},
+			steps: []step{
+				{id: "1"},
+				{id: "2", elapsed: 30 * time.Second},
+				{id: "1", elapsed: 50 * time.Second},
+				{id: "1", elapsed: 2 * time.Minute},
+				{id: "2", elapsed: 2 * time.Minute},
+			},
+			want:           []bool{false, false, true, false, false},
+			wantSuppressed: 1,
+			wantLen:        2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewTweetDeduplicator(tt.opts)
+			got := make([]bool, len(tt.steps))
+			for i, s := range tt.steps {
+				now := start.Add(s.elapsed)
+				d.now = func() time.Time { return now }
+				got[i] = d.Duplicate(s.id)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TweetDeduplicator.Duplicate() = %v, want %v", got, tt.want)
+			}
+			if d.Suppressed() != tt.wantSuppressed {
+				t.Errorf("TweetDeduplicator.Suppressed() = %v, want %v", d.Suppressed(), tt.wantSuppressed)
+			}
+			if d.Len() != tt.wantLen {
+				t.Errorf("TweetDeduplicator.Len() = %v, want %v", d.Len(), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestTweetDeduplicator_SaveLoad(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	d := NewTweetDeduplicator(TweetDeduplicatorOpts{Window: time.Hour})
+	d.now = func() time.Time { return now }
+	d.Duplicate("1")
+	d.Duplicate("2")
+	d.Duplicate("2")
+
+	buf := &bytes.Buffer{}
+	if err := d.Save(buf); err != nil {
+		t.Fatalf("TweetDeduplicator.Save() error = %v", err)
+	}
+
+	restored := NewTweetDeduplicator(TweetDeduplicatorOpts{Window: time.Hour})
+	restored.now = func() time.Time { return now.Add(30 * time.Minute) }
+	if err := restored.Load(buf); err != nil {
+		t.Fatalf("TweetDeduplicator.Load() error = %v", err)
+	}
+	if restored.Suppressed() != 1 {
+		t.Errorf("TweetDeduplicator.Load() suppressed = %v, want %v", restored.Suppressed(), 1)
+	}
+	if !restored.Duplicate("1") || !restored.Duplicate("2") {
+		t.Errorf("TweetDeduplicator.Load() want ids to be remembered")
+	}
+	if restored.Duplicate("3") {
+		t.Errorf("TweetDeduplicator.Load() want id to not be remembered")
+	}
+
+	if err := restored.Load(strings.NewReader("not json")); err == nil {
+		t.Errorf("TweetDeduplicator.Load() want error")
+	}
+}
+
+func TestTweetDeduplicator_LoadUnordered(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	d := NewTweetDeduplicator(TweetDeduplicatorOpts{Window: time.Hour})
+	d.now = func() time.Time { return now }
+
+	state := `{"suppressed":0,"entries":[
+		{"id":"new","seen":"2022-03-01T09:50:00Z"},
+		{"id":"old","seen":"2022-03-01T09:10:00Z"},
+		{"id":"middle","seen":"2022-03-01T09:30:00Z"}
+	]}`
+	if err := d.Load(strings.NewReader(state)); err != nil {
+		t.Fatalf("TweetDeduplicator.Load() error = %v", err)
+	}
+	if d.Len() != 3 {
+		t.Fatalf("TweetDeduplicator.Load() len = %d, want 3", d.Len())
+	}
+
+	// only the oldest entry is outside of the window
+	now = now.Add(15 * time.Minute)
+	if d.Duplicate("old") {
+		t.Errorf("TweetDeduplicator.Duplicate() want old to be expired")
+	}
+	if !d.Duplicate("middle") || !d.Duplicate("new") {
+		t.Errorf("TweetDeduplicator.Duplicate() want middle and new to be remembered")
+	}
+}
+
+func Test_StartTweetStreamWithDeduplicator(t *testing.T) {
+	d := NewTweetDeduplicator(TweetDeduplicatorOpts{})
+	d.Duplicate("1")
+
+	body := `{"data":{"id":"1","text":"hello"}}`
+	body += "\r\n"
+	body += `{"data":{"id":"2","text":"world"}}`
+	body += "\r\n"
+	body += `{"data":{"id":"2","text":"world"}}`
+	stream := StartTweetStreamWithDeduplicator(io.NopCloser(strings.NewReader(body)), d)
+
+	got := []*TweetMessage{}
+	timer := time.NewTimer(time.Second * 2)
+	func() {
+		defer stream.Close()
+		for {
+			select {
+			case msg := <-stream.Tweets():
+				got = append(got, msg)
+			case <-timer.C:
+				return
+			case err := <-stream.Err():
+				t.Errorf("StartTweetStreamWithDeduplicator error %v", err)
+				return
+			}
+		}
+	}()
+
+	want := []*TweetMessage{
+		{
+			Raw: &TweetRaw{
+				Tweets: []*TweetObj{
+					{
+						ID:   "2",
+						Text: "world",
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StartTweetStreamWithDeduplicator = %v, want %v", got, want)
+	}
+	if d.Suppressed() != 2 {
+		t.Errorf("StartTweetStreamWithDeduplicator suppressed = %v, want %v", d.Suppressed(), 2)
+	}
+}
@@ -0,0 +1,136 @@
+package twitter
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// The weighted length follows the twitter-text configuration.  The text is normalized, each url counts as 23
+// characters, each emoji counts as 2 characters and the characters outside of the light weight ranges, such
+// as the CJK characters, count as 2 characters.
+const (
+	tweetTextMaxWeightedLength = 280
+	tweetTextScale             = 100
+	tweetTextDefaultWeight     = 200
+	tweetTextLightWeight       = 100
+	tweetTextURLLength         = 23
+)
+
+type tweetTextRange struct {
+	start rune
+	end   rune
+}
+
+var tweetTextLightWeightRanges = []tweetTextRange{
+	{start: 0x0000, end: 0x10FF},
+	{start: 0x2000, end: 0x200D},
+	{start: 0x2010, end: 0x201F},
+	{start: 0x2032, end: 0x2037},
+}
+
+var tweetTextEmojiRanges = []tweetTextRange{
+	{start: 0x2300, end: 0x23FF},
+	{start: 0x2600, end: 0x27BF},
+	{start: 0x2B00, end: 0x2BFF},
+	{start: 0x3030, end: 0x3030},
+	{start: 0x303D, end: 0x303D},
+	{start: 0x3297, end: 0x3299},
+	{start: 0x1F000, end: 0x1FAFF},
+}
+
+// tweetTextURLRegex matches the urls with a scheme, starting with www or ending with a top level domain.  Like
+// twitter-text, a domain with one name and a country code top level domain, like file.py, is only an url with a
+// path.  The first group is the character before the url, so that email addresses and mentions are not urls.
+var tweetTextURLRegex = func() *regexp.Regexp {
+	const (
+		label = `[a-z0-9](?:[a-z0-9\-]*[a-z0-9])?\.`
+		path  = `/[^\s<>"]*[^\s<>".,:;!?'\)\]}]`
+	)
+	generic := `(?:` + strings.Join(tweetTextGenericTLDs, "|") + `)\b`
+	country := `(?:` + strings.Join(tweetTextCountryTLDs, "|") + `)\b`
+	return regexp.MustCompile(`(?i)(^|[^\w@.\-/])((?:https?://|www\.)[^\s<>"]*[^\s<>".,:;!?'\)\]}]|` +
+		`(?:` + label + `)+` + generic + `(?:` + path + `)?|` +
+		`(?:` + label + `){2,}` + country + `(?:` + path + `)?|` +
+		label + country + path + `|` +
+		`t\.co\b(?:` + path + `)?)`)
+}()
+
+// TweetWeightedLength returns the length of the tweet text as it is counted by twitter, which is limited to 280
+func TweetWeightedLength(text string) int {
+	text = norm.NFC.String(text)
+
+	weight := 0
+	cursor := 0
+	for _, loc := range tweetTextURLRegex.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[4], loc[5]
+		weight += tweetTextSegmentWeight(text[cursor:start])
+		weight += tweetTextURLLength * tweetTextScale
+		cursor = end
+	}
+	weight += tweetTextSegmentWeight(text[cursor:])
+
+	return weight / tweetTextScale
+}
+
+func tweetTextSegmentWeight(segment string) int {
+	runes := []rune(segment)
+	weight := 0
+	for i := 0; i < len(runes); {
+		if n := tweetTextEmojiLength(runes[i:]); n > 0 {
+			weight += tweetTextDefaultWeight
+			i += n
+			continue
+		}
+		switch {
+		case tweetTextInRanges(runes[i], tweetTextLightWeightRanges):
+			weight += tweetTextLightWeight
+		default:
+			weight += tweetTextDefaultWeight
+		}
+		i++
+	}
+	return weight
+}
+
+// tweetTextEmojiLength returns the number of runes of the emoji sequence at the start of the runes, which
+// includes the skin tone modifiers, variation selectors, keycaps, tags and zero width joined emoji
+func tweetTextEmojiLength(runes []rune) int {
+	switch {
+	case isRegionalIndicator(runes[0]) && len(runes) > 1 && isRegionalIndicator(runes[1]):
+		return 2
+	case isRegionalIndicator(runes[0]):
+		return 1
+	case tweetTextInRanges(runes[0], tweetTextEmojiRanges):
+	case len(runes) > 1 && (runes[1] == 0xFE0F || runes[1] == 0x20E3):
+	default:
+		return 0
+	}
+
+	n := 1
+	for n < len(runes) {
+		switch r := runes[n]; {
+		case r == 0xFE0F, r == 0x20E3, r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F:
+			n++
+		case r == 0x200D && n+1 < len(runes):
+			n += 2
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+func tweetTextInRanges(r rune, ranges []tweetTextRange) bool {
+	for _, rr := range ranges {
+		if r >= rr.start && r <= rr.end {
+			return true
+		}
+	}
+	return false
+}
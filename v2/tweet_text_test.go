@@ -0,0 +1,77 @@
+package twitter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTweetWeightedLength(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{
+			name: "ascii",
+			text: "Hello World",
+			want: 11,
+		},
+		{
+			name: "url",
+			text: "go to https://go.dev/doc/effective_go.html now",
+			want: 33,
+		},
+		{
+			name: "url without scheme",
+			text: "go to go.dev and www.golang.org.",
+			want: 3 + 2 + 23 + 5 + 23 + 1 + 1,
+		},
+		{
+			name: "url country and new top level domains",
+			text: "see bbc.co.uk, heise.de/news and https://openai.ai/x",
+			want: 4 + 23 + 2 + 23 + 5 + 23,
+		},
+		{
+			name: "country domain with one name needs a path",
+			text: "run main.py or see t.co and go.io/x",
+			want: 19 + 23 + 5 + 23,
+		},
+		{
+			name: "file names and abbreviations are not urls",
+			text: "Node.js, Mr.Smith, config.yaml, e.g.something",
+			want: 45,
+		},
+		{
+			name: "email is not an url",
+			text: "dev@go.dev",
+			want: 10,
+		},
+		{
+			name: "cjk",
+			text: "こんにちは",
+			want: 10,
+		},
+		{
+			name: "emoji",
+			text: "👋🏽 👨‍👩‍👧 🇺🇸 1️⃣",
+			want: 11,
+		},
+		{
+			name: "normalized",
+			text: "é",
+			want: 1,
+		},
+		{
+			name: "over the max length",
+			text: strings.Repeat("a", 257) + " https://t.co/abc",
+			want: 281,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TweetWeightedLength(tt.text); got != tt.want {
+				t.Errorf("TweetWeightedLength() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
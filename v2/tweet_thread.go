@@ -0,0 +1,195 @@
+package twitter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// TweetThreadSegment is a tweet of the thread with optional media
+type TweetThreadSegment struct {
+	Text  string            `json:"text"`
+	Media *CreateTweetMedia `json:"media,omitempty"`
+}
+
+// TweetThreadOpts are the options for composing the thread.
+//
+// Numbering will add " 1/n" to the end of each of the tweets.
+//
+// InReplyToTweetID will post the thread as a reply to the tweet, otherwise the first tweet starts the thread.
+//
+// ReplySettings and LongForm are applied to each of the tweets.
+type TweetThreadOpts struct {
+	Numbering        bool   `json:"numbering,omitempty"`
+	InReplyToTweetID string `json:"in_reply_to_tweet_id,omitempty"`
+	ReplySettings    string `json:"reply_settings,omitempty"`
+	LongForm         bool   `json:"long_form,omitempty"`
+}
+
+// TweetThread is the thread to post.  Posted are the tweets of the segments that have been posted, in order, so the
+// thread can be resumed or rolled back.  The thread can be encoded to resume in another process.
+type TweetThread struct {
+	Segments []TweetThreadSegment `json:"segments"`
+	Opts     TweetThreadOpts      `json:"opts"`
+	Posted   []*CreateTweetData   `json:"posted,omitempty"`
+}
+
+// TweetThreadResponse is the response from posting the thread
+type TweetThreadResponse struct {
+	Tweets    []*CreateTweetData
+	RateLimit *RateLimit
+}
+
+// TweetThreadRollbackResponse is the response from deleting the posted tweets of the thread
+type TweetThreadRollbackResponse struct {
+	DeletedIDs []string
+	RateLimit  *RateLimit
+}
+
+// TweetThreadError is returned when a segment of the thread could not be posted.  The thread can be resumed by
+// posting it again or the posted tweets can be deleted by rolling it back.
+type TweetThreadError struct {
+	Index  int
+	Posted []*CreateTweetData
+	Err    error
+}
+
+func (e *TweetThreadError) Error() string {
+	return fmt.Sprintf("tweet thread segment %d, %d posted: %v", e.Index, len(e.Posted), e.Err)
+}
+
+// Unwrap will return the wrapped error
+func (e *TweetThreadError) Unwrap() error {
+	return e.Err
+}
+
+// NewTweetThread will split the text into the segments of the thread
+func NewTweetThread(text string, opts TweetThreadOpts) (*TweetThread, error) {
+	segments := SplitTweetThread(text, opts)
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("tweet thread: text is required %w", ErrParameter)
+	}
+	thread := &TweetThread{
+		Segments: make([]TweetThreadSegment, len(segments)),
+		Opts:     opts,
+	}
+	for i, segment := range segments {
+		thread.Segments[i] = TweetThreadSegment{
+			Text: segment,
+		}
+	}
+	return thread, nil
+}
+
+// Done returns true if all of the segments have been posted
+func (t *TweetThread) Done() bool {
+	return len(t.Posted) >= len(t.Segments)
+}
+
+// requests will return the requests of each of the segments, without the reply which is the previous tweet
+func (t *TweetThread) requests() ([]CreateTweetRequest, error) {
+	if len(t.Segments) == 0 {
+		return nil, fmt.Errorf("tweet thread: segments are required %w", ErrParameter)
+	}
+	reqs := make([]CreateTweetRequest, len(t.Segments))
+	for i, segment := range t.Segments {
+		text := segment.Text
+		if t.Opts.Numbering {
+			text = strings.TrimSpace(strings.TrimSpace(text) + tweetThreadNumber(i, len(t.Segments)))
+		}
+		reqs[i] = CreateTweetRequest{
+			Text:          text,
+			ReplySettings: t.Opts.ReplySettings,
+			Media:         segment.Media,
+			LongForm:      t.Opts.LongForm,
+		}
+		if err := reqs[i].validate(); err != nil {
+			return nil, fmt.Errorf("tweet thread segment %d: %w", i, err)
+		}
+	}
+	return reqs, nil
+}
+
+func tweetThreadNumber(idx, total int) string {
+	return fmt.Sprintf(" %d/%d", idx+1, total)
+}
+
+var tweetThreadWordRegex = regexp.MustCompile(`\S+\s*`)
+
+// SplitTweetThread will split the text into tweets at the sentence or word boundaries using the weighted length.
+// If numbering is set, the length of the number is reserved in each of the tweets.
+func SplitTweetThread(text string, opts TweetThreadOpts) []string {
+	maxLength := createTweetTextMaxLength
+	if opts.LongForm {
+		maxLength = createTweetLongFormTextMaxLength
+	}
+	if !opts.Numbering {
+		return splitTweetThread(text, maxLength, "")
+	}
+
+	// the number of tweets changes the length of the numbering, so split until the number is stable
+	total := len(splitTweetThread(text, maxLength, ""))
+	for {
+		segments := splitTweetThread(text, maxLength, tweetThreadNumber(total-1, total))
+		if len(segments) <= total {
+			return segments
+		}
+		total = len(segments)
+	}
+}
+
+func splitTweetThread(text string, maxLength int, suffix string) []string {
+	words := tweetThreadWordRegex.FindAllString(strings.TrimSpace(text), -1)
+
+	segments := []string{}
+	chunk := []string{}
+	fits := func(words []string) bool {
+		return TweetWeightedLength(strings.TrimSpace(strings.Join(words, ""))+suffix) <= maxLength
+	}
+	for len(words) > 0 {
+		word := words[0]
+		if fits(append(chunk, word)) {
+			chunk = append(chunk, word)
+			words = words[1:]
+			continue
+		}
+		if len(chunk) == 0 {
+			// the word is longer than a tweet, so it is split
+			head, tail := splitTweetThreadWord(word, maxLength, suffix)
+			segments = append(segments, head)
+			words[0] = tail
+			continue
+		}
+
+		// break at the last sentence if the tweet is at least half full, otherwise at the word
+		end := len(chunk)
+		for i := len(chunk) - 1; i > 0; i-- {
+			if tweetThreadSentenceEnd(chunk[i-1]) && TweetWeightedLength(strings.Join(chunk[:i], "")) >= maxLength/2 {
+				end = i
+				break
+			}
+		}
+		segments = append(segments, strings.TrimSpace(strings.Join(chunk[:end], "")))
+		words = append(append([]string{}, chunk[end:]...), words...)
+		chunk = []string{}
+	}
+	if len(chunk) > 0 {
+		segments = append(segments, strings.TrimSpace(strings.Join(chunk, "")))
+	}
+	return segments
+}
+
+func tweetThreadSentenceEnd(word string) bool {
+	word = strings.TrimRight(strings.TrimSpace(word), `"')]`)
+	return strings.HasSuffix(word, ".") || strings.HasSuffix(word, "!") || strings.HasSuffix(word, "?")
+}
+
+// splitTweetThreadWord will split the word at the most runes that will fit
+func splitTweetThreadWord(word string, maxLength int, suffix string) (string, string) {
+	runes := []rune(word)
+	end := 1
+	for end < len(runes) && TweetWeightedLength(string(runes[:end+1])+suffix) <= maxLength {
+		end++
+	}
+	return string(runes[:end]), string(runes[end:])
+}
@@ -0,0 +1,134 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	usageTweetsMinDays = 1
+	usageTweetsMaxDays = 90
+)
+
+// UsageField can expand the usage information
+type UsageField string
+
+const (
+	// UsageFieldCapResetDay is the day of the month that the project cap is reset
+	UsageFieldCapResetDay UsageField = "cap_reset_day"
+	// UsageFieldDailyClientAppUsage is the daily usage for each of the client apps
+	UsageFieldDailyClientAppUsage UsageField = "daily_client_app_usage"
+	// UsageFieldDailyProjectUsage is the daily usage for the project
+	UsageFieldDailyProjectUsage UsageField = "daily_project_usage"
+	// UsageFieldProjectCap is the monthly tweet cap of the project
+	UsageFieldProjectCap UsageField = "project_cap"
+	// UsageFieldProjectID is the id of the project
+	UsageFieldProjectID UsageField = "project_id"
+	// UsageFieldProjectUsage is the number of tweets consumed in the current billing period
+	UsageFieldProjectUsage UsageField = "project_usage"
+)
+
+func usageFieldStringArray(arr []UsageField) []string {
+	strs := make([]string, len(arr))
+	for i, field := range arr {
+		strs[i] = string(field)
+	}
+	return strs
+}
+
+// UsageTweetsOpts are the usage tweets options
+//
+// Days is the number of days of usage to return, between 1 and 90.  If zero, twitter defaults to 7 days.
+type UsageTweetsOpts struct {
+	Days        int
+	UsageFields []UsageField
+}
+
+func (u UsageTweetsOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if u.Days > 0 {
+		q.Add("days", strconv.Itoa(u.Days))
+	}
+	if len(u.UsageFields) > 0 {
+		q.Add("usage.fields", strings.Join(usageFieldStringArray(u.UsageFields), ","))
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// UsageCount is a tweet count from the usage endpoint, twitter will return the counts as strings
+type UsageCount int64
+
+// UnmarshalJSON will decode the count from either a string or a number
+func (u *UsageCount) UnmarshalJSON(data []byte) error {
+	str := strings.Trim(string(data), `"`)
+	if len(str) == 0 || str == "null" {
+		*u = 0
+		return nil
+	}
+	count, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return fmt.Errorf("usage count %s: %w", string(data), err)
+	}
+	*u = UsageCount(count)
+	return nil
+}
+
+// UsageDayObj is the tweet usage for a day
+type UsageDayObj struct {
+	Date  string     `json:"date"`
+	Usage UsageCount `json:"usage"`
+}
+
+// DateTime returns the date as a time
+func (u UsageDayObj) DateTime() (time.Time, error) {
+	return parseTime(u.Date)
+}
+
+// UsageDailyProjectObj is the daily tweet usage of the project
+type UsageDailyProjectObj struct {
+	ProjectID string         `json:"project_id"`
+	Usage     []*UsageDayObj `json:"usage"`
+}
+
+// UsageDailyClientAppObj is the daily tweet usage of a client app in the project
+type UsageDailyClientAppObj struct {
+	ClientAppID      string         `json:"client_app_id"`
+	Usage            []*UsageDayObj `json:"usage"`
+	UsageResultCount int            `json:"usage_result_count"`
+}
+
+// UsageTweetsObj is the tweet usage of the project
+type UsageTweetsObj struct {
+	CapResetDay         int                       `json:"cap_reset_day"`
+	ProjectID           string                    `json:"project_id"`
+	ProjectCap          UsageCount                `json:"project_cap"`
+	ProjectUsage        UsageCount                `json:"project_usage"`
+	DailyProjectUsage   *UsageDailyProjectObj     `json:"daily_project_usage"`
+	DailyClientAppUsage []*UsageDailyClientAppObj `json:"daily_client_app_usage"`
+}
+
+// Remaining is the number of tweets left before the project cap is reached
+func (u UsageTweetsObj) Remaining() int64 {
+	remaining := int64(u.ProjectCap) - int64(u.ProjectUsage)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// UsageTweetsRaw is the raw response from the usage tweets
+type UsageTweetsRaw struct {
+	Usage  *UsageTweetsObj `json:"data"`
+	Errors []*ErrorObj     `json:"errors,omitempty"`
+}
+
+// UsageTweetsResponse is the response from the usage tweets
+type UsageTweetsResponse struct {
+	Raw       *UsageTweetsRaw
+	RateLimit *RateLimit
+}
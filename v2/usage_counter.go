@@ -0,0 +1,139 @@
+package twitter
+
+import "sync"
+
+// TweetUsageSource is the type of call that returned the tweets
+type TweetUsageSource string
+
+const (
+	// TweetUsageSourceSearch are the tweets from the recent and full-archive search
+	TweetUsageSourceSearch TweetUsageSource = "search"
+	// TweetUsageSourceTimeline are the tweets from the user timelines
+	TweetUsageSourceTimeline TweetUsageSource = "timeline"
+	// TweetUsageSourceStream are the tweets from the filtered, sample and partition streams
+	TweetUsageSourceStream TweetUsageSource = "stream"
+)
+
+// TweetUsageAlertFunc is called when the tweet count crosses the alert threshold
+type TweetUsageAlertFunc func(count, tweetCap int64)
+
+// TweetUsageCounterOpts are the options for the tweet usage counter.
+//
+// Cap is the monthly tweet cap of the project.  If zero, no alert is raised.
+//
+// AlertThreshold is the fraction of the cap, like 0.9, that will raise the alert.  If zero, the alert is raised when the cap is reached.
+//
+// OnAlert is called once when the threshold is crossed, until the counter is reset.
+type TweetUsageCounterOpts struct {
+	Cap            int64
+	AlertThreshold float64
+	OnAlert        TweetUsageAlertFunc
+}
+
+// TweetUsageCounter will tally the tweets returned by the search, timeline and stream callouts.  It can be set
+// on the client to raise an alert before the monthly tweet cap is reached, and compared with the usage tweets
+// endpoint to check the count against twitter.  The counter is safe for concurrent use.
+type TweetUsageCounter struct {
+	tweetCap  int64
+	threshold int64
+	onAlert   TweetUsageAlertFunc
+	count     int64
+	sources   map[TweetUsageSource]int64
+	alerted   bool
+	mutex     sync.Mutex
+}
+
+// TweetUsageComparison is the comparison of the client count with twitter's project usage
+type TweetUsageComparison struct {
+	Counted    int64
+	Reported   int64
+	Difference int64
+}
+
+// NewTweetUsageCounter will create a tweet usage counter
+func NewTweetUsageCounter(opts TweetUsageCounterOpts) *TweetUsageCounter {
+	threshold := opts.Cap
+	if opts.AlertThreshold > 0 && opts.AlertThreshold < 1 {
+		threshold = int64(float64(opts.Cap) * opts.AlertThreshold)
+	}
+	return &TweetUsageCounter{
+		tweetCap:  opts.Cap,
+		threshold: threshold,
+		onAlert:   opts.OnAlert,
+		sources:   map[TweetUsageSource]int64{},
+	}
+}
+
+// Add will add the number of tweets from the source
+func (u *TweetUsageCounter) Add(source TweetUsageSource, tweets int) {
+	if u == nil || tweets <= 0 {
+		return
+	}
+
+	u.mutex.Lock()
+	u.count += int64(tweets)
+	u.sources[source] += int64(tweets)
+	alert := u.tweetCap > 0 && !u.alerted && u.count >= u.threshold
+	if alert {
+		u.alerted = true
+	}
+	count := u.count
+	u.mutex.Unlock()
+
+	// the alert is called outside of the lock so the callback can use the counter
+	if alert && u.onAlert != nil {
+		u.onAlert(count, u.tweetCap)
+	}
+}
+
+// Count is the number of tweets that have been counted
+func (u *TweetUsageCounter) Count() int64 {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	return u.count
+}
+
+// BySource is the number of tweets that have been counted for each source
+func (u *TweetUsageCounter) BySource() map[TweetUsageSource]int64 {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	sources := make(map[TweetUsageSource]int64, len(u.sources))
+	for source, count := range u.sources {
+		sources[source] = count
+	}
+	return sources
+}
+
+// Remaining is the number of tweets left before the cap is reached
+func (u *TweetUsageCounter) Remaining() int64 {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	if u.count >= u.tweetCap {
+		return 0
+	}
+	return u.tweetCap - u.count
+}
+
+// Reset will clear the count and the alert, this should be called when the cap resets
+func (u *TweetUsageCounter) Reset() {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	u.count = 0
+	u.sources = map[TweetUsageSource]int64{}
+	u.alerted = false
+}
+
+// Compare will compare the count with the project usage from the usage tweets endpoint.  A positive
+// difference is the number of tweets counted by twitter that were not counted by the client.
+func (u *TweetUsageCounter) Compare(usage *UsageTweetsObj) TweetUsageComparison {
+	count := u.Count()
+	var reported int64
+	if usage != nil {
+		reported = int64(usage.ProjectUsage)
+	}
+	return TweetUsageComparison{
+		Counted:    count,
+		Reported:   reported,
+		Difference: reported - count,
+	}
+}
@@ -3,7 +3,6 @@ package twitter
 import (
 	"net/http"
 	"strconv"
-	"strings"
 )
 
 // UserLikesResponse the response for the user likes
@@ -39,36 +38,14 @@ type UserLikesMeta struct {
 
 // UserLikesLookupOpts the tweet like lookup options
 type UserLikesLookupOpts struct {
-	Expansions      []Expansion
-	MediaFields     []MediaField
-	PlaceFields     []PlaceField
-	PollFields      []PollField
-	TweetFields     []TweetField
-	UserFields      []UserField
+	TweetSelection
 	MaxResults      int
 	PaginationToken string
 }
 
 func (t UserLikesLookupOpts) addQuery(req *http.Request) {
 	q := req.URL.Query()
-	if len(t.Expansions) > 0 {
-		q.Add("expansions", strings.Join(expansionStringArray(t.Expansions), ","))
-	}
-	if len(t.MediaFields) > 0 {
-		q.Add("media.fields", strings.Join(mediaFieldStringArray(t.MediaFields), ","))
-	}
-	if len(t.PlaceFields) > 0 {
-		q.Add("place.fields", strings.Join(placeFieldStringArray(t.PlaceFields), ","))
-	}
-	if len(t.PollFields) > 0 {
-		q.Add("poll.fields", strings.Join(pollFieldStringArray(t.PollFields), ","))
-	}
-	if len(t.TweetFields) > 0 {
-		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(t.TweetFields), ","))
-	}
-	if len(t.UserFields) > 0 {
-		q.Add("user.fields", strings.Join(userFieldStringArray(t.UserFields), ","))
-	}
+	t.TweetSelection.addQuery(q)
 	if t.MaxResults > 0 {
 		q.Add("max_results", strconv.Itoa(t.MaxResults))
 	}
@@ -1,5 +1,7 @@
 package twitter
 
+import "time"
+
 // UserField defines the twitter user account metadata fields
 type UserField string
 
@@ -60,6 +62,11 @@ type UserObj struct {
 	WithHeld        *WithHeldObj    `json:"withheld,omitempty"`
 }
 
+// CreatedAtTime returns the created at as a time
+func (u UserObj) CreatedAtTime() (time.Time, error) {
+	return parseTime(u.CreatedAt)
+}
+
 // UserMetricsObj contains details about activity for this user
 type UserMetricsObj struct {
 	Followers int `json:"followers_count"`
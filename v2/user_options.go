@@ -90,3 +90,34 @@ func (u UserFollowersLookupOpts) addQuery(req *http.Request) {
 		req.URL.RawQuery = q.Encode()
 	}
 }
+
+// UserSearchOpts are the options for the user search
+type UserSearchOpts struct {
+	Expansions  []Expansion
+	TweetFields []TweetField
+	UserFields  []UserField
+	MaxResults  int
+	NextToken   string
+}
+
+func (u UserSearchOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if len(u.Expansions) > 0 {
+		q.Add("expansions", strings.Join(expansionStringArray(u.Expansions), ","))
+	}
+	if len(u.TweetFields) > 0 {
+		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(u.TweetFields), ","))
+	}
+	if len(u.UserFields) > 0 {
+		q.Add("user.fields", strings.Join(userFieldStringArray(u.UserFields), ","))
+	}
+	if u.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(u.MaxResults))
+	}
+	if len(u.NextToken) > 0 {
+		q.Add("next_token", u.NextToken)
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
@@ -107,3 +107,16 @@ func (u *UserRawIncludes) tweetsByID() map[string]*TweetObj {
 	}
 	return u.pinnedTweets
 }
+
+// UserSearchResponse is the response from the user search
+type UserSearchResponse struct {
+	Raw       *UserRaw
+	Meta      *UserSearchMeta `json:"meta"`
+	RateLimit *RateLimit
+}
+
+// UserSearchMeta is the meta data returned by the user search
+type UserSearchMeta struct {
+	ResultCount int    `json:"result_count"`
+	NextToken   string `json:"next_token"`
+}
@@ -0,0 +1,237 @@
+package twitter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+const (
+	webhookSignatureHeader = "x-twitter-webhooks-signature"
+	webhookSignaturePrefix = "sha256="
+	webhookMaxBodySize     = 5 << 20
+)
+
+// ErrWebhookSignature will indicate that the webhook request signature is missing or not valid
+var ErrWebhookSignature = errors.New("twitter webhook signature error")
+
+// WebhookActivityHandler is called with each of the account activity payloads
+type WebhookActivityHandler func(activity *WebhookActivity)
+
+// WebhookTweetHandler is called with a tweet create event
+type WebhookTweetHandler func(activity *WebhookActivity, tweet *WebhookTweetObj)
+
+// WebhookFavoriteHandler is called with a favorite event
+type WebhookFavoriteHandler func(activity *WebhookActivity, event *WebhookFavoriteEvent)
+
+// WebhookFollowHandler is called with a follow event
+type WebhookFollowHandler func(activity *WebhookActivity, event *WebhookFollowEvent)
+
+// WebhookDirectMessageHandler is called with a direct message event
+type WebhookDirectMessageHandler func(activity *WebhookActivity, event *WebhookDirectMessageEvent)
+
+// WebhookErrorHandler is called when a request to the webhook can not be handled
+type WebhookErrorHandler func(err error)
+
+// WebhookCRCResponseToken will return the response token for the challenge response check, which is the
+// base64 HMAC SHA-256 of the CRC token with the consumer secret
+func WebhookCRCResponseToken(consumerSecret, crcToken string) string {
+	return webhookSignaturePrefix + webhookHMAC(consumerSecret, []byte(crcToken))
+}
+
+// VerifyWebhookSignature will return true if the signature header matches the HMAC SHA-256 of the body
+func VerifyWebhookSignature(consumerSecret string, body []byte, signature string) bool {
+	expected := webhookSignaturePrefix + webhookHMAC(consumerSecret, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func webhookHMAC(consumerSecret string, msg []byte) string {
+	mac := hmac.New(sha256.New, []byte(consumerSecret))
+	mac.Write(msg)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookHandler is the HTTP handler for the account activity webhook.  The GET request will answer the
+// challenge response check, and the POST request will verify the signature and dispatch the events to the
+// registered handlers.  The handlers are called before the response is written, and twitter expects a
+// response within three seconds, so long running work should not be done in the handler.
+type WebhookHandler struct {
+	consumerSecret string
+	activity       []WebhookActivityHandler
+	tweetCreate    []WebhookTweetHandler
+	mention        []WebhookTweetHandler
+	favorite       []WebhookFavoriteHandler
+	follow         []WebhookFollowHandler
+	directMessage  []WebhookDirectMessageHandler
+	errs           []WebhookErrorHandler
+	mutex          sync.RWMutex
+}
+
+// NewWebhookHandler will create the webhook handler with the app consumer secret
+func NewWebhookHandler(consumerSecret string) *WebhookHandler {
+	return &WebhookHandler{
+		consumerSecret: consumerSecret,
+	}
+}
+
+// OnActivity will register a handler for each of the account activity payloads
+func (h *WebhookHandler) OnActivity(handler WebhookActivityHandler) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.activity = append(h.activity, handler)
+}
+
+// OnTweetCreate will register a handler for the tweet create events, which are the tweets, retweets, replies
+// and mentions of the subscribed user
+func (h *WebhookHandler) OnTweetCreate(handler WebhookTweetHandler) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.tweetCreate = append(h.tweetCreate, handler)
+}
+
+// OnMention will register a handler for the tweet create events that mention the subscribed user and are
+// not authored by the subscribed user
+func (h *WebhookHandler) OnMention(handler WebhookTweetHandler) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.mention = append(h.mention, handler)
+}
+
+// OnFavorite will register a handler for the favorite events
+func (h *WebhookHandler) OnFavorite(handler WebhookFavoriteHandler) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.favorite = append(h.favorite, handler)
+}
+
+// OnFollow will register a handler for the follow and unfollow events
+func (h *WebhookHandler) OnFollow(handler WebhookFollowHandler) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.follow = append(h.follow, handler)
+}
+
+// OnDirectMessage will register a handler for the direct message events
+func (h *WebhookHandler) OnDirectMessage(handler WebhookDirectMessageHandler) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.directMessage = append(h.directMessage, handler)
+}
+
+// OnError will register a handler for the requests that have failed the signature check or can not be decoded
+func (h *WebhookHandler) OnError(handler WebhookErrorHandler) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.errs = append(h.errs, handler)
+}
+
+// ServeHTTP will handle the challenge response check and the account activity requests
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		h.serveCRC(w, req)
+	case http.MethodPost:
+		h.serveActivity(w, req)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *WebhookHandler) serveCRC(w http.ResponseWriter, req *http.Request) {
+	crcToken := req.URL.Query().Get("crc_token")
+	if len(crcToken) == 0 {
+		h.sendErr(fmt.Errorf("webhook crc: a crc token is required: %w", ErrParameter))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := struct {
+		ResponseToken string `json:"response_token"`
+	}{
+		ResponseToken: WebhookCRCResponseToken(h.consumerSecret, crcToken),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.sendErr(fmt.Errorf("webhook crc response: %w", err))
+	}
+}
+
+func (h *WebhookHandler) serveActivity(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(req.Body, webhookMaxBodySize))
+	if err != nil {
+		h.sendErr(fmt.Errorf("webhook activity read: %w", err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !VerifyWebhookSignature(h.consumerSecret, body, req.Header.Get(webhookSignatureHeader)) {
+		h.sendErr(fmt.Errorf("webhook activity: %w", ErrWebhookSignature))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	activity := &WebhookActivity{}
+	if err := json.Unmarshal(body, activity); err != nil {
+		h.sendErr(&ResponseDecodeError{
+			Name: "webhook activity",
+			Err:  err,
+		})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.Dispatch(activity)
+	w.WriteHeader(http.StatusOK)
+}
+
+// Dispatch will send the activity events to the registered handlers
+func (h *WebhookHandler) Dispatch(activity *WebhookActivity) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	for _, handler := range h.activity {
+		handler(activity)
+	}
+	for _, tweet := range activity.TweetCreateEvents {
+		for _, handler := range h.tweetCreate {
+			handler(activity, tweet)
+		}
+		authored := tweet.User != nil && tweet.User.ID == activity.ForUserID
+		if authored || !tweet.Mentions(activity.ForUserID) {
+			continue
+		}
+		for _, handler := range h.mention {
+			handler(activity, tweet)
+		}
+	}
+	for _, event := range activity.FavoriteEvents {
+		for _, handler := range h.favorite {
+			handler(activity, event)
+		}
+	}
+	for _, event := range activity.FollowEvents {
+		for _, handler := range h.follow {
+			handler(activity, event)
+		}
+	}
+	for _, event := range activity.DirectMessageEvents {
+		for _, handler := range h.directMessage {
+			handler(activity, event)
+		}
+	}
+}
+
+func (h *WebhookHandler) sendErr(err error) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	for _, handler := range h.errs {
+		handler(err)
+	}
+}
@@ -0,0 +1,192 @@
+package twitter
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWebhookHandler_CRC(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     string
+		wantStatus int
+		wantToken  string
+	}{
+		{
+			name:       "success",
+			target:     "/webhook?crc_token=challenge",
+			wantStatus: http.StatusOK,
+			wantToken:  "sha256=oeUF6Wxqoezggrue+wbIDxKRPSF6esKwizR2MHh9HaA=",
+		},
+		{
+			name:       "no token",
+			target:     "/webhook",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(NewWebhookHandler("secret"))
+			defer server.Close()
+
+			resp, err := http.Get(server.URL + tt.target)
+			if err != nil {
+				t.Fatalf("WebhookHandler CRC error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("WebhookHandler CRC status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			got := struct {
+				ResponseToken string `json:"response_token"`
+			}{}
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("WebhookHandler CRC decode error = %v", err)
+			}
+			if got.ResponseToken != tt.wantToken {
+				t.Errorf("WebhookHandler CRC = %v, want %v", got.ResponseToken, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_Activity(t *testing.T) {
+	body := `{
+		"for_user_id": "2244994945",
+		"tweet_create_events": [
+			{
+				"id_str": "1",
+				"text": "@TwitterDev hello",
+				"user": {"id_str": "6253282", "screen_name": "TwitterAPI"},
+				"entities": {"user_mentions": [{"id_str": "2244994945", "screen_name": "TwitterDev"}]}
+			},
+			{
+				"id_str": "2",
+				"text": "hello @TwitterDev",
+				"user": {"id_str": "2244994945", "screen_name": "TwitterDev"},
+				"entities": {"user_mentions": [{"id_str": "2244994945", "screen_name": "TwitterDev"}]}
+			}
+		],
+		"favorite_events": [
+			{
+				"id": "a7ba59eab0bfcba386f7acedac279542",
+				"created_at": "Mon Mar 26 16:33:26 +0000 2018",
+				"timestamp_ms": 1522082006140,
+				"favorited_status": {"id_str": "2", "text": "hello @TwitterDev"},
+				"user": {"id_str": "6253282", "screen_name": "TwitterAPI"}
+			}
+		],
+		"follow_events": [
+			{
+				"type": "follow",
+				"created_timestamp": "1517588749178",
+				"target": {"id_str": "2244994945"},
+				"source": {"id_str": "6253282"}
+			}
+		],
+		"direct_message_events": [
+			{
+				"type": "message_create",
+				"id": "954491830116155396",
+				"created_timestamp": "1516403560557",
+				"message_create": {
+					"target": {"recipient_id": "2244994945"},
+					"sender_id": "6253282",
+					"message_data": {"text": "Hello World!"}
+				}
+			}
+		],
+		"users": {
+			"6253282": {"id": "6253282", "screen_name": "TwitterAPI"}
+		}
+	}`
+
+	tests := []struct {
+		name       string
+		signature  func(body string) string
+		wantStatus int
+		wantEvents []string
+		wantErr    error
+	}{
+		{
+			name: "success",
+			// the signature is the same HMAC as the crc response token
+			signature: func(body string) string {
+				return WebhookCRCResponseToken("secret", body)
+			},
+			wantStatus: http.StatusOK,
+			wantEvents: []string{"activity", "tweet 1", "mention 1", "tweet 2", "favorite 2", "follow 6253282", "dm Hello World! 6253282"},
+		},
+		{
+			name: "bad signature",
+			signature: func(body string) string {
+				return WebhookCRCResponseToken("other", body)
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantEvents: []string{},
+			wantErr:    ErrWebhookSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := []string{}
+			var gotErr error
+			handler := NewWebhookHandler("secret")
+			handler.OnActivity(func(activity *WebhookActivity) {
+				events = append(events, "activity")
+			})
+			handler.OnTweetCreate(func(activity *WebhookActivity, tweet *WebhookTweetObj) {
+				events = append(events, "tweet "+tweet.ID)
+			})
+			handler.OnMention(func(activity *WebhookActivity, tweet *WebhookTweetObj) {
+				events = append(events, "mention "+tweet.ID)
+			})
+			handler.OnFavorite(func(activity *WebhookActivity, event *WebhookFavoriteEvent) {
+				events = append(events, "favorite "+event.FavoritedStatus.ID)
+			})
+			handler.OnFollow(func(activity *WebhookActivity, event *WebhookFollowEvent) {
+				events = append(events, string(event.Type)+" "+event.Source.ID)
+			})
+			handler.OnDirectMessage(func(activity *WebhookActivity, event *WebhookDirectMessageEvent) {
+				sender := activity.Users[event.MessageCreate.SenderID]
+				events = append(events, "dm "+event.MessageCreate.MessageData.Text+" "+sender.ID)
+			})
+			handler.OnError(func(err error) {
+				gotErr = err
+			})
+
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("WebhookHandler request error = %v", err)
+			}
+			req.Header.Set(webhookSignatureHeader, tt.signature(body))
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("WebhookHandler error = %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("WebhookHandler status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(events, tt.wantEvents) {
+				t.Errorf("WebhookHandler events = %v, want %v", events, tt.wantEvents)
+			}
+			if !errors.Is(gotErr, tt.wantErr) {
+				t.Errorf("WebhookHandler error = %v, want %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package twitter
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// WebhookFollowType is the type of follow event
+type WebhookFollowType string
+
+const (
+	// WebhookFollowTypeFollow is when an user follows another user
+	WebhookFollowTypeFollow WebhookFollowType = "follow"
+	// WebhookFollowTypeUnfollow is when an user unfollows another user
+	WebhookFollowTypeUnfollow WebhookFollowType = "unfollow"
+)
+
+// WebhookDirectMessageType is the type of direct message event
+type WebhookDirectMessageType string
+
+const (
+	// WebhookDirectMessageTypeMessageCreate is a direct message that has been sent or received
+	WebhookDirectMessageTypeMessageCreate WebhookDirectMessageType = "message_create"
+)
+
+// WebhookUserObj is the v1.1 user object that is in the account activity events
+type WebhookUserObj struct {
+	ID              string `json:"id_str"`
+	Name            string `json:"name"`
+	ScreenName      string `json:"screen_name"`
+	Location        string `json:"location"`
+	Description     string `json:"description"`
+	Protected       bool   `json:"protected"`
+	Verified        bool   `json:"verified"`
+	FollowersCount  int    `json:"followers_count"`
+	FriendsCount    int    `json:"friends_count"`
+	StatusesCount   int    `json:"statuses_count"`
+	CreatedAt       string `json:"created_at"`
+	ProfileImageURL string `json:"profile_image_url_https"`
+}
+
+// CreatedAtTime returns the created at as a time
+func (u WebhookUserObj) CreatedAtTime() (time.Time, error) {
+	return parseV1Time(u.CreatedAt)
+}
+
+// UnmarshalJSON will decode the user, the users of the direct message events have the id as a string in the id field
+func (u *WebhookUserObj) UnmarshalJSON(data []byte) error {
+	type user WebhookUserObj
+	obj := struct {
+		*user
+		RawID json.RawMessage `json:"id"`
+	}{
+		user: (*user)(u),
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	if len(u.ID) == 0 && len(obj.RawID) > 0 {
+		u.ID = strings.Trim(string(obj.RawID), `"`)
+	}
+	return nil
+}
+
+// WebhookUserMentionObj is an user mentioned in the tweet
+type WebhookUserMentionObj struct {
+	ID         string `json:"id_str"`
+	Name       string `json:"name"`
+	ScreenName string `json:"screen_name"`
+	Indices    []int  `json:"indices"`
+}
+
+// WebhookTweetEntitiesObj are the entities of the tweet
+type WebhookTweetEntitiesObj struct {
+	UserMentions []*WebhookUserMentionObj `json:"user_mentions"`
+	Hashtags     []json.RawMessage        `json:"hashtags"`
+	URLs         []json.RawMessage        `json:"urls"`
+}
+
+// WebhookTweetObj is the v1.1 tweet object that is in the account activity events
+type WebhookTweetObj struct {
+	ID                  string                   `json:"id_str"`
+	Text                string                   `json:"text"`
+	CreatedAt           string                   `json:"created_at"`
+	Source              string                   `json:"source"`
+	Truncated           bool                     `json:"truncated"`
+	InReplyToStatusID   string                   `json:"in_reply_to_status_id_str"`
+	InReplyToUserID     string                   `json:"in_reply_to_user_id_str"`
+	InReplyToScreenName string                   `json:"in_reply_to_screen_name"`
+	User                *WebhookUserObj          `json:"user"`
+	Entities            *WebhookTweetEntitiesObj `json:"entities"`
+	ExtendedTweet       *WebhookExtendedTweetObj `json:"extended_tweet"`
+	QuotedStatus        *WebhookTweetObj         `json:"quoted_status"`
+	RetweetedStatus     *WebhookTweetObj         `json:"retweeted_status"`
+	Lang                string                   `json:"lang"`
+	TimestampMS         string                   `json:"timestamp_ms"`
+}
+
+// CreatedAtTime returns the created at as a time
+func (t WebhookTweetObj) CreatedAtTime() (time.Time, error) {
+	return parseV1Time(t.CreatedAt)
+}
+
+// WebhookExtendedTweetObj is the full text of a tweet that is over 140 characters
+type WebhookExtendedTweetObj struct {
+	FullText         string                   `json:"full_text"`
+	DisplayTextRange []int                    `json:"display_text_range"`
+	Entities         *WebhookTweetEntitiesObj `json:"entities"`
+}
+
+// FullText will return the extended text of the tweet if it has been truncated
+func (t WebhookTweetObj) FullText() string {
+	if t.ExtendedTweet != nil && len(t.ExtendedTweet.FullText) > 0 {
+		return t.ExtendedTweet.FullText
+	}
+	return t.Text
+}
+
+// Mentions will return true if the user is mentioned in the tweet
+func (t WebhookTweetObj) Mentions(userID string) bool {
+	entities := []*WebhookTweetEntitiesObj{t.Entities}
+	if t.ExtendedTweet != nil {
+		entities = append(entities, t.ExtendedTweet.Entities)
+	}
+	for _, e := range entities {
+		if e == nil {
+			continue
+		}
+		for _, mention := range e.UserMentions {
+			if mention.ID == userID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WebhookFavoriteEvent is when a tweet is liked by, or a tweet of, the subscribed user
+type WebhookFavoriteEvent struct {
+	ID              string           `json:"id"`
+	CreatedAt       string           `json:"created_at"`
+	TimestampMS     int64            `json:"timestamp_ms"`
+	FavoritedStatus *WebhookTweetObj `json:"favorited_status"`
+	User            *WebhookUserObj  `json:"user"`
+}
+
+// CreatedAtTime returns the created at as a time
+func (w WebhookFavoriteEvent) CreatedAtTime() (time.Time, error) {
+	return parseV1Time(w.CreatedAt)
+}
+
+// WebhookFollowEvent is when the subscribed user follows, or is followed by, another user
+type WebhookFollowEvent struct {
+	Type             WebhookFollowType `json:"type"`
+	CreatedTimestamp string            `json:"created_timestamp"`
+	Target           *WebhookUserObj   `json:"target"`
+	Source           *WebhookUserObj   `json:"source"`
+}
+
+// CreatedTimestampTime returns the created timestamp as a time
+func (w WebhookFollowEvent) CreatedTimestampTime() (time.Time, error) {
+	return parseV1Time(w.CreatedTimestamp)
+}
+
+// WebhookMessageTargetObj is the recipient of the direct message
+type WebhookMessageTargetObj struct {
+	RecipientID string `json:"recipient_id"`
+}
+
+// WebhookMessageDataObj is the content of the direct message
+type WebhookMessageDataObj struct {
+	Text       string          `json:"text"`
+	Entities   json.RawMessage `json:"entities,omitempty"`
+	Attachment json.RawMessage `json:"attachment,omitempty"`
+}
+
+// WebhookMessageCreateObj is the direct message that was created
+type WebhookMessageCreateObj struct {
+	Target      *WebhookMessageTargetObj `json:"target"`
+	SenderID    string                   `json:"sender_id"`
+	SourceAppID string                   `json:"source_app_id"`
+	MessageData *WebhookMessageDataObj   `json:"message_data"`
+}
+
+// WebhookDirectMessageEvent is a direct message sent or received by the subscribed user
+type WebhookDirectMessageEvent struct {
+	Type             WebhookDirectMessageType `json:"type"`
+	ID               string                   `json:"id"`
+	CreatedTimestamp string                   `json:"created_timestamp"`
+	MessageCreate    *WebhookMessageCreateObj `json:"message_create"`
+}
+
+// CreatedTimestampTime returns the created timestamp as a time
+func (w WebhookDirectMessageEvent) CreatedTimestampTime() (time.Time, error) {
+	return parseV1Time(w.CreatedTimestamp)
+}
+
+// WebhookActivity is the account activity payload that is sent to the webhook.  ForUserID is the subscribed
+// user that the events are for, and Users are the users referenced by the direct message events.
+type WebhookActivity struct {
+	ForUserID           string                       `json:"for_user_id"`
+	UserHasBlocked      bool                         `json:"user_has_blocked"`
+	TweetCreateEvents   []*WebhookTweetObj           `json:"tweet_create_events"`
+	FavoriteEvents      []*WebhookFavoriteEvent      `json:"favorite_events"`
+	FollowEvents        []*WebhookFollowEvent        `json:"follow_events"`
+	DirectMessageEvents []*WebhookDirectMessageEvent `json:"direct_message_events"`
+	Users               map[string]*WebhookUserObj   `json:"users"`
+}